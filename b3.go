@@ -0,0 +1,96 @@
+package marketchecker
+
+import (
+	"time"
+)
+
+// B3 represents B3 (Brasil, Bolsa, Balcão), the São Paulo stock exchange
+//
+// Brazil no longer observes daylight saving time, but B3 shifts its trading
+// hours whenever the United States changes clocks so that the overlap with
+// the New York session is preserved.
+type B3 struct {
+	holidayProvider HolidayProvider
+}
+
+var (
+	// B3 timezone (Brasília Time)
+	b3Location *time.Location
+)
+
+func init() {
+	b3Location = mustLoadLocation("America/Sao_Paulo")
+}
+
+// NewB3 creates a new B3 market instance
+func NewB3() *B3 {
+	return &B3{
+		holidayProvider: NewB3HolidayProvider(b3Location),
+	}
+}
+
+// Name returns the market name
+func (b *B3) Name() string {
+	return "B3"
+}
+
+// Location returns the market timezone
+func (b *B3) Location() *time.Location {
+	return b3Location
+}
+
+// IsOpen checks if B3 is open for trading at the given time
+func (b *B3) IsOpen(t time.Time) bool {
+	status := b.GetStatus(t)
+	return status == StatusOpen
+}
+
+// GetStatus returns the current market status at the given time
+func (b *B3) GetStatus(t time.Time) MarketStatus {
+	return statusAt(b, t)
+}
+
+// SessionsOn returns the B3 trading sessions belonging to the given trading date
+func (b *B3) SessionsOn(date time.Time) []Session {
+	day := startOfDay(date, b3Location)
+	if !b.IsTradingDay(day) {
+		return nil
+	}
+
+	// B3 equities trading hours (BRT), including the closing call:
+	// While the US observes daylight saving time: 10:00 AM - 5:00 PM
+	// Otherwise: 10:00 AM - 6:00 PM
+	// On Ash Wednesday the session opens at 1:00 PM
+
+	regular := TimeRange{
+		Start: TimeOfDay{Hour: 10},
+		End:   TimeOfDay{Hour: 18},
+	}
+
+	if isUSDaylightSaving(day) {
+		regular.End = TimeOfDay{Hour: 17}
+	}
+
+	if isEasterRelative(day.Year(), day.Month(), day.Day(), -46) {
+		regular.Start = TimeOfDay{Hour: 13}
+	}
+
+	return []Session{
+		newSession(b.Name(), "regular", StatusOpen, day, regular, day),
+	}
+}
+
+// isUSDaylightSaving checks if New York observes daylight saving time on the given calendar date
+func isUSDaylightSaving(t time.Time) bool {
+	noon := time.Date(t.Year(), t.Month(), t.Day(), 12, 0, 0, 0, nasdaqLocation)
+	return noon.IsDST()
+}
+
+// IsTradingDay checks if the given date is a B3 trading day
+func (b *B3) IsTradingDay(t time.Time) bool {
+	localTime := t.In(b3Location)
+	if b.holidayProvider != nil && b.holidayProvider.IsHoliday(localTime) {
+		return false
+	}
+	return !IsWeekend(localTime)
+}
@@ -0,0 +1,107 @@
+package marketchecker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestB3_RegularHours(t *testing.T) {
+	b3 := NewB3()
+
+	loc, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+	regularTime := time.Date(2026, 1, 20, 11, 0, 0, 0, loc) // Tuesday
+
+	if !b3.IsOpen(regularTime) {
+		t.Errorf("B3 should be open at %v", regularTime)
+	}
+
+	status := b3.GetStatus(regularTime)
+	if status != StatusOpen {
+		t.Errorf("Expected status %s, got %s", StatusOpen, status)
+	}
+}
+
+func TestB3_USDaylightSavingShift(t *testing.T) {
+	b3 := NewB3()
+
+	loc, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	// US standard time: session runs until 6:00 PM BRT
+	winter := time.Date(2026, 1, 20, 17, 30, 0, 0, loc)
+	if !b3.IsOpen(winter) {
+		t.Errorf("B3 should be open at %v while the US is on standard time", winter)
+	}
+
+	// US daylight saving time: session ends at 5:00 PM BRT
+	summer := time.Date(2026, 7, 14, 17, 30, 0, 0, loc)
+	if b3.IsOpen(summer) {
+		t.Errorf("B3 should be closed at %v while the US is on daylight saving time", summer)
+	}
+}
+
+func TestB3_Carnival(t *testing.T) {
+	b3 := NewB3()
+
+	loc, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	// Carnival Monday and Tuesday 2026
+	for _, day := range []int{16, 17} {
+		carnival := time.Date(2026, 2, day, 11, 0, 0, 0, loc)
+		if b3.IsOpen(carnival) {
+			t.Errorf("B3 should be closed for Carnival at %v", carnival)
+		}
+	}
+
+	// Ash Wednesday opens at 1:00 PM
+	ashWednesdayMorning := time.Date(2026, 2, 18, 11, 0, 0, 0, loc)
+	if b3.IsOpen(ashWednesdayMorning) {
+		t.Errorf("B3 should be closed on Ash Wednesday morning at %v", ashWednesdayMorning)
+	}
+	ashWednesdayAfternoon := time.Date(2026, 2, 18, 14, 0, 0, 0, loc)
+	if !b3.IsOpen(ashWednesdayAfternoon) {
+		t.Errorf("B3 should be open on Ash Wednesday afternoon at %v", ashWednesdayAfternoon)
+	}
+}
+
+func TestB3_Name(t *testing.T) {
+	b3 := NewB3()
+	if b3.Name() != "B3" {
+		t.Errorf("Expected name 'B3', got '%s'", b3.Name())
+	}
+}
+
+func TestB3_HolidayClosed(t *testing.T) {
+	b3 := NewB3()
+
+	loc, err := time.LoadLocation("America/Sao_Paulo")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	holidays := []struct {
+		name string
+		date time.Time
+	}{
+		{"Tiradentes", time.Date(2026, 4, 21, 11, 0, 0, 0, loc)},
+		{"Corpus Christi", time.Date(2026, 6, 4, 11, 0, 0, 0, loc)},
+		{"Consciência Negra", time.Date(2026, 11, 20, 11, 0, 0, 0, loc)},
+		{"Christmas Eve", time.Date(2026, 12, 24, 11, 0, 0, 0, loc)},
+		{"Last weekday of the year", time.Date(2026, 12, 31, 11, 0, 0, 0, loc)},
+		{"Last weekday of the year (weekend Dec 31)", time.Date(2027, 12, 31, 11, 0, 0, 0, loc)},
+	}
+
+	for _, h := range holidays {
+		if b3.IsOpen(h.date) {
+			t.Errorf("B3 should be closed on %s at %v", h.name, h.date)
+		}
+	}
+}
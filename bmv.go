@@ -0,0 +1,88 @@
+package marketchecker
+
+import (
+	"time"
+)
+
+// BMV represents the Bolsa Mexicana de Valores (Mexican Stock Exchange)
+//
+// Mexico no longer observes daylight saving time, but BMV keeps its session
+// aligned with New York, so local trading hours move one hour earlier while
+// the US observes daylight saving time.
+type BMV struct {
+	holidayProvider HolidayProvider
+}
+
+var (
+	// BMV timezone (Central Mexico Time)
+	bmvLocation *time.Location
+)
+
+func init() {
+	bmvLocation = mustLoadLocation("America/Mexico_City")
+}
+
+// NewBMV creates a new BMV market instance
+func NewBMV() *BMV {
+	return &BMV{
+		holidayProvider: NewBMVHolidayProvider(bmvLocation),
+	}
+}
+
+// Name returns the market name
+func (m *BMV) Name() string {
+	return "BMV"
+}
+
+// Location returns the market timezone
+func (m *BMV) Location() *time.Location {
+	return bmvLocation
+}
+
+// IsOpen checks if BMV is open for trading at the given time
+func (m *BMV) IsOpen(t time.Time) bool {
+	status := m.GetStatus(t)
+	return status == StatusOpen
+}
+
+// GetStatus returns the current market status at the given time
+func (m *BMV) GetStatus(t time.Time) MarketStatus {
+	return statusAt(m, t)
+}
+
+// SessionsOn returns the BMV trading sessions belonging to the given trading date
+func (m *BMV) SessionsOn(date time.Time) []Session {
+	day := startOfDay(date, bmvLocation)
+	if !m.IsTradingDay(day) {
+		return nil
+	}
+
+	// BMV trading hours (Central Mexico Time):
+	// Regular session: 8:30 AM - 3:00 PM
+	// While the US observes daylight saving time: 7:30 AM - 2:00 PM
+
+	regular := TimeRange{
+		Start: TimeOfDay{Hour: 8, Minute: 30},
+		End:   TimeOfDay{Hour: 15},
+	}
+
+	if isUSDaylightSaving(day) {
+		regular = TimeRange{
+			Start: TimeOfDay{Hour: 7, Minute: 30},
+			End:   TimeOfDay{Hour: 14},
+		}
+	}
+
+	return []Session{
+		newSession(m.Name(), "regular", StatusOpen, day, regular, day),
+	}
+}
+
+// IsTradingDay checks if the given date is a BMV trading day
+func (m *BMV) IsTradingDay(t time.Time) bool {
+	localTime := t.In(bmvLocation)
+	if m.holidayProvider != nil && m.holidayProvider.IsHoliday(localTime) {
+		return false
+	}
+	return !IsWeekend(localTime)
+}
@@ -0,0 +1,85 @@
+package marketchecker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBMV_RegularHours(t *testing.T) {
+	bmv := NewBMV()
+
+	loc, err := time.LoadLocation("America/Mexico_City")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+	regularTime := time.Date(2026, 1, 20, 10, 0, 0, 0, loc) // Tuesday
+
+	if !bmv.IsOpen(regularTime) {
+		t.Errorf("BMV should be open at %v", regularTime)
+	}
+
+	status := bmv.GetStatus(regularTime)
+	if status != StatusOpen {
+		t.Errorf("Expected status %s, got %s", StatusOpen, status)
+	}
+}
+
+func TestBMV_USDaylightSavingShift(t *testing.T) {
+	bmv := NewBMV()
+
+	loc, err := time.LoadLocation("America/Mexico_City")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	// US standard time: 8:30 AM - 3:00 PM
+	if bmv.IsOpen(time.Date(2026, 1, 20, 8, 0, 0, 0, loc)) {
+		t.Error("BMV should not be open at 8:00 AM while the US is on standard time")
+	}
+	if !bmv.IsOpen(time.Date(2026, 1, 20, 14, 30, 0, 0, loc)) {
+		t.Error("BMV should be open at 2:30 PM while the US is on standard time")
+	}
+
+	// US daylight saving time: 7:30 AM - 2:00 PM
+	if !bmv.IsOpen(time.Date(2026, 7, 14, 7, 45, 0, 0, loc)) {
+		t.Error("BMV should be open at 7:45 AM while the US is on daylight saving time")
+	}
+	if bmv.IsOpen(time.Date(2026, 7, 14, 14, 30, 0, 0, loc)) {
+		t.Error("BMV should not be open at 2:30 PM while the US is on daylight saving time")
+	}
+}
+
+func TestBMV_Name(t *testing.T) {
+	bmv := NewBMV()
+	if bmv.Name() != "BMV" {
+		t.Errorf("Expected name 'BMV', got '%s'", bmv.Name())
+	}
+}
+
+func TestBMV_HolidayClosed(t *testing.T) {
+	bmv := NewBMV()
+
+	loc, err := time.LoadLocation("America/Mexico_City")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	holidays := []struct {
+		name string
+		date time.Time
+	}{
+		{"Constitution Day", time.Date(2026, 2, 2, 10, 0, 0, 0, loc)},
+		{"Benito Juárez's Birthday", time.Date(2026, 3, 16, 10, 0, 0, 0, loc)},
+		{"Holy Thursday", time.Date(2026, 4, 2, 10, 0, 0, 0, loc)},
+		{"Good Friday", time.Date(2026, 4, 3, 10, 0, 0, 0, loc)},
+		{"Independence Day", time.Date(2026, 9, 16, 10, 0, 0, 0, loc)},
+		{"Revolution Day", time.Date(2026, 11, 16, 10, 0, 0, 0, loc)},
+		{"Día de la Virgen de Guadalupe", time.Date(2025, 12, 12, 10, 0, 0, 0, loc)},
+	}
+
+	for _, h := range holidays {
+		if bmv.IsOpen(h.date) {
+			t.Errorf("BMV should be closed on %s at %v", h.name, h.date)
+		}
+	}
+}
@@ -0,0 +1,209 @@
+package marketchecker
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// maxTradingDaySearch bounds the number of calendar days scanned when looking for a trading day
+const maxTradingDaySearch = 366
+
+// TradingCalendar is implemented by markets that expose their trading-day calendar
+// All built-in markets implement TradingCalendar.
+type TradingCalendar interface {
+	// IsTradingDay checks if the given date is a trading day in the market's timezone
+	IsTradingDay(t time.Time) bool
+	// Location returns the market timezone
+	Location() *time.Location
+}
+
+// CalendarCoverage is implemented by markets whose holiday data only covers a limited period
+// HKEX, China A-Share and the markets derived from them carry holiday lists for specific years;
+// the Checker returns ErrCalendarNotCovered for dates outside them instead of guessing.
+type CalendarCoverage interface {
+	// Covers checks if the holiday data covers the given date
+	Covers(t time.Time) bool
+}
+
+// IsTradingDay checks if the given date is a trading day for the specified market
+// The date is interpreted in the market's timezone.
+func (c *Checker) IsTradingDay(marketType MarketType, date time.Time) (bool, error) {
+	calendar, err := c.tradingCalendar(marketType)
+	if err != nil {
+		return false, err
+	}
+	if err := checkCoverage(calendar, date); err != nil {
+		return false, err
+	}
+	return calendar.IsTradingDay(date), nil
+}
+
+// NextTradingDay returns the first trading day after the given date for the specified market
+// The result is midnight of the trading day in the market's timezone.
+func (c *Checker) NextTradingDay(marketType MarketType, date time.Time) (time.Time, error) {
+	calendar, err := c.tradingCalendar(marketType)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return stepTradingDay(calendar, startOfDay(date, calendar.Location()), 1)
+}
+
+// PreviousTradingDay returns the last trading day before the given date for the specified market
+// The result is midnight of the trading day in the market's timezone.
+func (c *Checker) PreviousTradingDay(marketType MarketType, date time.Time) (time.Time, error) {
+	calendar, err := c.tradingCalendar(marketType)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return stepTradingDay(calendar, startOfDay(date, calendar.Location()), -1)
+}
+
+// AddTradingDays returns the date n trading days after the given date for the specified market
+// A negative n counts backwards. If the given date is not a trading day, n = 0
+// returns the next trading day. The result is midnight of the trading day in
+// the market's timezone.
+func (c *Checker) AddTradingDays(marketType MarketType, date time.Time, n int) (time.Time, error) {
+	calendar, err := c.tradingCalendar(marketType)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return addTradingDays(calendar, date, n)
+}
+
+// TradingDaysBetween returns the number of trading days after a up to and including b for the specified market
+// The result is negative if b is before a, so that AddTradingDays(a, TradingDaysBetween(a, b)) == b
+// whenever a and b are trading days.
+func (c *Checker) TradingDaysBetween(marketType MarketType, a, b time.Time) (int, error) {
+	calendar, err := c.tradingCalendar(marketType)
+	if err != nil {
+		return 0, err
+	}
+
+	loc := calendar.Location()
+	from := startOfDay(a, loc)
+	to := startOfDay(b, loc)
+
+	sign := 1
+	if to.Before(from) {
+		from, to = to, from
+		sign = -1
+	}
+
+	count := 0
+	for day := from.AddDate(0, 0, 1); !day.After(to); day = day.AddDate(0, 0, 1) {
+		if err := checkCoverage(calendar, day); err != nil {
+			return 0, err
+		}
+		if calendar.IsTradingDay(day) {
+			count++
+		}
+	}
+	return sign * count, nil
+}
+
+// tradingCalendar returns the trading calendar of the specified market
+func (c *Checker) tradingCalendar(marketType MarketType) (TradingCalendar, error) {
+	market, err := c.GetMarket(marketType)
+	if err != nil {
+		return nil, err
+	}
+	calendar, ok := market.(TradingCalendar)
+	if !ok {
+		return nil, fmt.Errorf("market %s does not provide a trading calendar: %w", marketType, errors.ErrUnsupported)
+	}
+	return calendar, nil
+}
+
+// addTradingDays returns the date n trading days after the given date according to the calendar
+func addTradingDays(calendar TradingCalendar, date time.Time, n int) (time.Time, error) {
+	day := startOfDay(date, calendar.Location())
+
+	if n == 0 {
+		if err := checkCoverage(calendar, day); err != nil {
+			return time.Time{}, err
+		}
+		if calendar.IsTradingDay(day) {
+			return day, nil
+		}
+		return stepTradingDay(calendar, day, 1)
+	}
+
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+
+	var err error
+	for ; n > 0; n-- {
+		day, err = stepTradingDay(calendar, day, step)
+		if err != nil {
+			return time.Time{}, err
+		}
+	}
+	return day, nil
+}
+
+// stepTradingDay returns the nearest trading day strictly after (step = 1) or before (step = -1) the given day
+func stepTradingDay(calendar TradingCalendar, day time.Time, step int) (time.Time, error) {
+	start := day
+	for i := 0; i < maxTradingDaySearch; i++ {
+		day = day.AddDate(0, 0, step)
+		if err := checkCoverage(calendar, day); err != nil {
+			return time.Time{}, err
+		}
+		if calendar.IsTradingDay(day) {
+			return day, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%w: no trading day within %d days of %s", ErrCalendarNotCovered, maxTradingDaySearch, start.Format("2006-01-02"))
+}
+
+// nextTradingDays returns the date n trading days after t according to isTradingDay
+func nextTradingDays(isTradingDay func(time.Time) bool, t time.Time, n int) time.Time {
+	for n > 0 {
+		t = t.AddDate(0, 0, 1)
+		if isTradingDay(t) {
+			n--
+		}
+	}
+	return t
+}
+
+// startOfDay returns midnight of the date of t in the given location
+func startOfDay(t time.Time, loc *time.Location) time.Time {
+	local := t.In(loc)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+}
+
+// checkCoverage returns a CalendarNotCoveredError if the calendar's holiday data does not cover the given date
+// Calendars that do not implement CalendarCoverage cover every date.
+func checkCoverage(calendar interface{}, t time.Time) error {
+	coverage, ok := calendar.(CalendarCoverage)
+	if !ok || coverage.Covers(t) {
+		return nil
+	}
+	name := ""
+	if named, ok := calendar.(interface{ Name() string }); ok {
+		name = named.Name()
+	}
+	return &CalendarNotCoveredError{Market: name, Date: t}
+}
+
+// checkRangeCoverage returns a CalendarNotCoveredError if the calendar's holiday data does not cover both ends of [from, to)
+func checkRangeCoverage(calendar interface{}, from, to time.Time) error {
+	if err := checkCoverage(calendar, from); err != nil {
+		return err
+	}
+	if to.After(from) {
+		return checkCoverage(calendar, to.Add(-time.Nanosecond))
+	}
+	return nil
+}
+
+// holidaysCover checks if the holiday provider covers the given date
+// Providers that do not implement CalendarCoverage, e.g. rule-based ones, cover every date.
+func holidaysCover(provider HolidayProvider, t time.Time) bool {
+	coverage, ok := provider.(CalendarCoverage)
+	return !ok || coverage.Covers(t)
+}
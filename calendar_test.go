@@ -0,0 +1,156 @@
+package marketchecker
+
+import (
+	"testing"
+	"time"
+)
+
+// statusOnlyMarket is a custom market that does not implement TradingCalendar
+type statusOnlyMarket struct{}
+
+func (statusOnlyMarket) IsOpen(t time.Time) bool            { return false }
+func (statusOnlyMarket) GetStatus(t time.Time) MarketStatus { return StatusClosed }
+func (statusOnlyMarket) Name() string                       { return "Status Only" }
+
+func TestChecker_IsTradingDay(t *testing.T) {
+	checker := NewChecker()
+
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	tests := []struct {
+		desc string
+		date time.Time
+		want bool
+	}{
+		{"MLK Day", time.Date(2026, 1, 19, 0, 0, 0, 0, loc), false},
+		{"Regular Tuesday", time.Date(2026, 1, 20, 0, 0, 0, 0, loc), true},
+		{"Saturday", time.Date(2026, 1, 24, 0, 0, 0, 0, loc), false},
+	}
+
+	for _, tt := range tests {
+		got, err := checker.IsTradingDay(MarketNASDAQ, tt.date)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if got != tt.want {
+			t.Errorf("%s: expected IsTradingDay %v, got %v", tt.desc, tt.want, got)
+		}
+	}
+}
+
+func TestChecker_NextAndPreviousTradingDay(t *testing.T) {
+	checker := NewChecker()
+
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	// Friday before the MLK Day weekend
+	friday := time.Date(2026, 1, 16, 15, 0, 0, 0, loc)
+	tuesday := time.Date(2026, 1, 20, 0, 0, 0, 0, loc)
+
+	next, err := checker.NextTradingDay(MarketNASDAQ, friday)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !next.Equal(tuesday) {
+		t.Errorf("Expected next trading day %v, got %v", tuesday, next)
+	}
+
+	previous, err := checker.PreviousTradingDay(MarketNASDAQ, tuesday)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if want := time.Date(2026, 1, 16, 0, 0, 0, 0, loc); !previous.Equal(want) {
+		t.Errorf("Expected previous trading day %v, got %v", want, previous)
+	}
+}
+
+func TestChecker_AddTradingDays(t *testing.T) {
+	checker := NewChecker()
+
+	loc, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	// Friday before Lunar New Year 2026
+	friday := time.Date(2026, 2, 13, 0, 0, 0, 0, loc)
+
+	tests := []struct {
+		market MarketType
+		date   time.Time
+		n      int
+		want   time.Time
+	}{
+		{MarketHKEX, friday, 1, time.Date(2026, 2, 16, 0, 0, 0, 0, loc)},
+		{MarketHKEX, friday, 2, time.Date(2026, 2, 20, 0, 0, 0, 0, loc)},
+		{MarketChinaAShare, friday, 1, time.Date(2026, 2, 23, 0, 0, 0, 0, loc)},
+		{MarketChinaAShare, time.Date(2026, 2, 23, 0, 0, 0, 0, loc), -1, friday},
+		{MarketChinaAShare, time.Date(2026, 2, 14, 0, 0, 0, 0, loc), 0, time.Date(2026, 2, 23, 0, 0, 0, 0, loc)},
+		{MarketChinaAShare, friday, 0, friday},
+	}
+
+	for _, tt := range tests {
+		got, err := checker.AddTradingDays(tt.market, tt.date, tt.n)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("%s: %v %+d trading days: expected %v, got %v", tt.market, tt.date, tt.n, tt.want, got)
+		}
+	}
+}
+
+func TestChecker_TradingDaysBetween(t *testing.T) {
+	checker := NewChecker()
+
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	from := time.Date(2026, 1, 16, 0, 0, 0, 0, loc) // Friday before MLK Day
+	to := time.Date(2026, 1, 23, 0, 0, 0, 0, loc)   // Following Friday
+
+	count, err := checker.TradingDaysBetween(MarketNASDAQ, from, to)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if count != 4 {
+		t.Errorf("Expected 4 trading days, got %d", count)
+	}
+
+	count, err = checker.TradingDaysBetween(MarketNASDAQ, to, from)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if count != -4 {
+		t.Errorf("Expected -4 trading days, got %d", count)
+	}
+
+	back, err := checker.AddTradingDays(MarketNASDAQ, from, count*-1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !back.Equal(to) {
+		t.Errorf("Expected AddTradingDays to round-trip to %v, got %v", to, back)
+	}
+}
+
+func TestChecker_TradingCalendarErrors(t *testing.T) {
+	checker := NewChecker()
+
+	if _, err := checker.IsTradingDay("UnknownMarket", time.Now()); err == nil {
+		t.Error("Expected error for unknown market type")
+	}
+
+	checker.AddMarket("StatusOnly", statusOnlyMarket{})
+	if _, err := checker.NextTradingDay("StatusOnly", time.Now()); err == nil {
+		t.Error("Expected error for market without a trading calendar")
+	}
+}
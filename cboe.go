@@ -0,0 +1,142 @@
+package marketchecker
+
+import (
+	"strings"
+	"time"
+)
+
+// OptionsProductClass identifies a group of US options products sharing the same trading hours
+type OptionsProductClass string
+
+const (
+	// OptionsEquity are single-stock options trading until 4:00 PM ET
+	OptionsEquity OptionsProductClass = "equity"
+	// OptionsETF are late-settling ETF options (e.g. SPY, QQQ, IWM) trading until 4:15 PM ET
+	OptionsETF OptionsProductClass = "etf"
+	// OptionsIndex are index options (e.g. SPX, XSP, VIX) with Global Trading Hours
+	OptionsIndex OptionsProductClass = "index"
+)
+
+// optionsProductClasses maps underlying symbols to non-equity product classes
+var optionsProductClasses = map[string]OptionsProductClass{
+	"SPX":  OptionsIndex,
+	"SPXW": OptionsIndex,
+	"XSP":  OptionsIndex,
+	"VIX":  OptionsIndex,
+	"VIXW": OptionsIndex,
+	"SPY":  OptionsETF,
+	"QQQ":  OptionsETF,
+	"IWM":  OptionsETF,
+	"DIA":  OptionsETF,
+}
+
+// LookupOptionsProductClass returns the product class of options on the given underlying symbol
+// Symbols that are not index or late-settling ETF options are treated as equity options.
+func LookupOptionsProductClass(underlying string) OptionsProductClass {
+	if class, ok := optionsProductClasses[strings.ToUpper(underlying)]; ok {
+		return class
+	}
+	return OptionsEquity
+}
+
+// CboeOptions represents the Cboe US options market for one product class
+type CboeOptions struct {
+	class           OptionsProductClass
+	holidayProvider HolidayProvider
+}
+
+// NewCboeOptions creates a new Cboe options market instance for the given product class
+func NewCboeOptions(class OptionsProductClass) *CboeOptions {
+	return &CboeOptions{
+		class:           class,
+		holidayProvider: NewDynamicHolidayProvider(nasdaqLocation),
+	}
+}
+
+// Name returns the market name
+func (o *CboeOptions) Name() string {
+	switch o.class {
+	case OptionsIndex:
+		return "Cboe Index Options"
+	case OptionsETF:
+		return "Cboe ETF Options"
+	default:
+		return "Cboe Equity Options"
+	}
+}
+
+// Location returns the market timezone
+func (o *CboeOptions) Location() *time.Location {
+	return nasdaqLocation
+}
+
+// ProductClass returns the options product class of the market
+func (o *CboeOptions) ProductClass() OptionsProductClass {
+	return o.class
+}
+
+// IsOpen checks if the regular trading session is open at the given time
+func (o *CboeOptions) IsOpen(t time.Time) bool {
+	status := o.GetStatus(t)
+	return status == StatusOpen
+}
+
+// GetStatus returns the current market status at the given time
+// Global Trading Hours are reported as overnight and the curb session as postmarket.
+func (o *CboeOptions) GetStatus(t time.Time) MarketStatus {
+	return statusAt(o, t)
+}
+
+// SessionsOn returns the trading sessions of the product class belonging to the given trading date
+// Global Trading Hours starting the evening before belong to the trading date.
+func (o *CboeOptions) SessionsOn(date time.Time) []Session {
+	day := startOfDay(date, nasdaqLocation)
+	if !o.IsTradingDay(day) {
+		return nil
+	}
+
+	// Cboe options trading hours (Eastern Time):
+	// Equity options: 9:30 AM - 4:00 PM
+	// Late-settling ETF options: 9:30 AM - 4:15 PM
+	// Index options: Global Trading Hours 8:15 PM (previous day) - 9:25 AM,
+	// 9:30 AM - 4:15 PM, curb session 4:15 PM - 5:00 PM
+
+	regular := TimeRange{
+		Start: TimeOfDay{Hour: 9, Minute: 30},
+		End:   TimeOfDay{Hour: 16},
+	}
+	if o.class == OptionsETF || o.class == OptionsIndex {
+		regular.End = TimeOfDay{Hour: 16, Minute: 15}
+	}
+
+	if o.class != OptionsIndex {
+		return []Session{
+			newSession(o.Name(), "regular", StatusOpen, day, regular, day),
+		}
+	}
+
+	gth := TimeRange{
+		Start: TimeOfDay{Hour: 20, Minute: 15},
+		End:   TimeOfDay{Hour: 9, Minute: 25},
+	}
+
+	curb := TimeRange{
+		Start: TimeOfDay{Hour: 16, Minute: 15},
+		End:   TimeOfDay{Hour: 17},
+	}
+
+	return []Session{
+		newSession(o.Name(), "global trading hours", StatusOvernight, day.AddDate(0, 0, -1), gth, day),
+		newSession(o.Name(), "regular", StatusOpen, day, regular, day),
+		newSession(o.Name(), "curb", StatusPostmarket, day, curb, day),
+	}
+}
+
+// IsTradingDay checks if the given date is a US options trading day
+func (o *CboeOptions) IsTradingDay(t time.Time) bool {
+	localTime := t.In(nasdaqLocation)
+	if o.holidayProvider != nil && o.holidayProvider.IsHoliday(localTime) {
+		return false
+	}
+	return !IsWeekend(localTime)
+}
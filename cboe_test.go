@@ -0,0 +1,102 @@
+package marketchecker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCboeOptions_ClosingTimeByProductClass(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	tests := []struct {
+		class OptionsProductClass
+		time  time.Time
+		want  MarketStatus
+	}{
+		{OptionsEquity, time.Date(2026, 1, 20, 15, 55, 0, 0, loc), StatusOpen},
+		{OptionsEquity, time.Date(2026, 1, 20, 16, 10, 0, 0, loc), StatusClosed},
+		{OptionsETF, time.Date(2026, 1, 20, 16, 10, 0, 0, loc), StatusOpen},
+		{OptionsETF, time.Date(2026, 1, 20, 16, 30, 0, 0, loc), StatusClosed},
+		{OptionsIndex, time.Date(2026, 1, 20, 16, 10, 0, 0, loc), StatusOpen},
+		{OptionsIndex, time.Date(2026, 1, 20, 16, 30, 0, 0, loc), StatusPostmarket},
+		{OptionsIndex, time.Date(2026, 1, 20, 17, 30, 0, 0, loc), StatusClosed},
+	}
+
+	for _, tt := range tests {
+		options := NewCboeOptions(tt.class)
+		if status := options.GetStatus(tt.time); status != tt.want {
+			t.Errorf("%s: expected status %s at %v, got %s", options.Name(), tt.want, tt.time, status)
+		}
+	}
+}
+
+func TestCboeOptions_GlobalTradingHours(t *testing.T) {
+	index := NewCboeOptions(OptionsIndex)
+	equity := NewCboeOptions(OptionsEquity)
+
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	tests := []struct {
+		desc string
+		time time.Time
+		want MarketStatus
+	}{
+		{"Sunday evening before Monday", time.Date(2026, 1, 25, 21, 0, 0, 0, loc), StatusOvernight},
+		{"Monday early morning", time.Date(2026, 1, 26, 8, 0, 0, 0, loc), StatusOvernight},
+		{"Global Trading Hours after 9:15", time.Date(2026, 1, 26, 9, 20, 0, 0, loc), StatusOvernight},
+		{"Gap before the regular open", time.Date(2026, 1, 26, 9, 27, 0, 0, loc), StatusClosed},
+		{"Friday evening", time.Date(2026, 1, 23, 21, 0, 0, 0, loc), StatusClosed},
+		{"Sunday evening before MLK Day", time.Date(2026, 1, 18, 21, 0, 0, 0, loc), StatusClosed},
+		{"MLK Day evening", time.Date(2026, 1, 19, 21, 0, 0, 0, loc), StatusOvernight},
+	}
+
+	for _, tt := range tests {
+		if status := index.GetStatus(tt.time); status != tt.want {
+			t.Errorf("%s: expected status %s at %v, got %s", tt.desc, tt.want, tt.time, status)
+		}
+	}
+
+	// Equity options have no Global Trading Hours
+	sundayEvening := time.Date(2026, 1, 25, 21, 0, 0, 0, loc)
+	if status := equity.GetStatus(sundayEvening); status != StatusClosed {
+		t.Errorf("Expected status %s for equity options at %v, got %s", StatusClosed, sundayEvening, status)
+	}
+}
+
+func TestCboeOptions_HolidayClosed(t *testing.T) {
+	index := NewCboeOptions(OptionsIndex)
+
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	christmas := time.Date(2026, 12, 25, 10, 0, 0, 0, loc)
+	if index.IsOpen(christmas) {
+		t.Errorf("Cboe index options should be closed on Christmas at %v", christmas)
+	}
+}
+
+func TestLookupOptionsProductClass(t *testing.T) {
+	tests := []struct {
+		underlying string
+		want       OptionsProductClass
+	}{
+		{"SPX", OptionsIndex},
+		{"vix", OptionsIndex},
+		{"SPY", OptionsETF},
+		{"AAPL", OptionsEquity},
+	}
+
+	for _, tt := range tests {
+		if got := LookupOptionsProductClass(tt.underlying); got != tt.want {
+			t.Errorf("%s: expected product class %s, got %s", tt.underlying, tt.want, got)
+		}
+	}
+}
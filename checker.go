@@ -1,7 +1,8 @@
 package marketchecker
 
 import (
-	"fmt"
+	"sort"
+	"sync"
 	"time"
 )
 
@@ -11,56 +12,239 @@ type MarketType string
 const (
 	// MarketNASDAQ represents NASDAQ
 	MarketNASDAQ MarketType = "NASDAQ"
+	// MarketCboeEquityOptions represents Cboe single-stock options
+	MarketCboeEquityOptions MarketType = "CboeEquityOptions"
+	// MarketCboeETFOptions represents Cboe late-settling ETF options
+	MarketCboeETFOptions MarketType = "CboeETFOptions"
+	// MarketCboeIndexOptions represents Cboe index options with Global Trading Hours
+	MarketCboeIndexOptions MarketType = "CboeIndexOptions"
+	// MarketUSBonds represents the US bond market (SIFMA calendar)
+	MarketUSBonds MarketType = "USBonds"
 	// MarketHKEX represents Hong Kong Exchange
 	MarketHKEX MarketType = "HKEX"
+	// MarketHKEXDerivatives represents Hong Kong Exchange futures and options
+	MarketHKEXDerivatives MarketType = "HKEXDerivatives"
 	// MarketChinaAShare represents China A-Share market
 	MarketChinaAShare MarketType = "ChinaAShare"
+	// MarketSSE represents the Shanghai Stock Exchange
+	MarketSSE MarketType = "SSE"
+	// MarketSZSE represents the Shenzhen Stock Exchange
+	MarketSZSE MarketType = "SZSE"
+	// MarketChinaMainBoard represents the SSE and SZSE Main Board
+	MarketChinaMainBoard MarketType = "ChinaMainBoard"
+	// MarketChinaSTAR represents the SSE STAR Market
+	MarketChinaSTAR MarketType = "ChinaSTAR"
+	// MarketChinaChiNext represents the SZSE ChiNext board
+	MarketChinaChiNext MarketType = "ChinaChiNext"
+	// MarketChinaBSE represents the Beijing Stock Exchange
+	MarketChinaBSE MarketType = "ChinaBSE"
+	// MarketSHFEPreciousMetals represents SHFE gold and silver futures
+	MarketSHFEPreciousMetals MarketType = "SHFEPreciousMetals"
+	// MarketSHFENonFerrousMetals represents SHFE base metal futures
+	MarketSHFENonFerrousMetals MarketType = "SHFENonFerrousMetals"
+	// MarketSHFEOtherCommodities represents SHFE ferrous, energy, rubber and pulp futures
+	MarketSHFEOtherCommodities MarketType = "SHFEOtherCommodities"
+	// MarketSHFEDayOnly represents SHFE futures without a night session
+	MarketSHFEDayOnly MarketType = "SHFEDayOnly"
+	// MarketDCENightTrading represents DCE futures with a night session
+	MarketDCENightTrading MarketType = "DCENightTrading"
+	// MarketDCEDayOnly represents DCE futures without a night session
+	MarketDCEDayOnly MarketType = "DCEDayOnly"
+	// MarketZCENightTrading represents ZCE futures with a night session
+	MarketZCENightTrading MarketType = "ZCENightTrading"
+	// MarketZCEDayOnly represents ZCE futures without a night session
+	MarketZCEDayOnly MarketType = "ZCEDayOnly"
+	// MarketCFFEXEquityIndex represents CFFEX stock index futures
+	MarketCFFEXEquityIndex MarketType = "CFFEXEquityIndex"
+	// MarketCFFEXTreasury represents CFFEX treasury bond futures
+	MarketCFFEXTreasury MarketType = "CFFEXTreasury"
+	// MarketINECrudeOil represents INE crude oil futures
+	MarketINECrudeOil MarketType = "INECrudeOil"
+	// MarketINEInternationalCopper represents INE international copper futures
+	MarketINEInternationalCopper MarketType = "INEInternationalCopper"
+	// MarketINEOtherCommodities represents INE low sulfur fuel oil and TSR 20 rubber futures
+	MarketINEOtherCommodities MarketType = "INEOtherCommodities"
+	// MarketINEDayOnly represents INE futures without a night session
+	MarketINEDayOnly MarketType = "INEDayOnly"
+	// MarketTSX represents Toronto Stock Exchange
+	MarketTSX MarketType = "TSX"
+	// MarketB3 represents B3 (Brasil, Bolsa, Balcão)
+	MarketB3 MarketType = "B3"
+	// MarketBMV represents Bolsa Mexicana de Valores
+	MarketBMV MarketType = "BMV"
+	// MarketStockConnectNorthbound represents Northbound Stock Connect (Hong Kong to Shanghai/Shenzhen)
+	MarketStockConnectNorthbound MarketType = "StockConnectNorthbound"
+	// MarketStockConnectSouthbound represents Southbound Stock Connect (Shanghai/Shenzhen to Hong Kong)
+	MarketStockConnectSouthbound MarketType = "StockConnectSouthbound"
 )
 
 // Checker provides a convenient interface to check market hours
+// A Checker is safe for concurrent use; markets can be added, replaced and removed while other goroutines query it.
 type Checker struct {
-	markets map[MarketType]Market
+	mu          sync.RWMutex
+	markets     map[MarketType]Market
+	settlements map[MarketType]SettlementConvention
+	clock       Clock
+	symbols     *SymbolResolver
+}
+
+// Option configures a Checker
+type Option func(*Checker)
+
+// WithClock sets the clock the checker uses for the current time and for timers
+func WithClock(clock Clock) Option {
+	return func(c *Checker) {
+		c.clock = clock
+	}
 }
 
 // NewChecker creates a new Checker instance
-func NewChecker() *Checker {
-	return &Checker{
+func NewChecker(opts ...Option) *Checker {
+	c := &Checker{
 		markets: map[MarketType]Market{
 			MarketNASDAQ:      NewNASDAQ(),
 			MarketHKEX:        NewHKEX(),
 			MarketChinaAShare: NewChinaAShare(),
+			MarketTSX:         NewTSX(),
+			MarketB3:          NewB3(),
+			MarketBMV:         NewBMV(),
+
+			MarketHKEXDerivatives: NewHKEXDerivatives(),
+			MarketUSBonds:         NewUSBonds(),
+
+			MarketCboeEquityOptions: NewCboeOptions(OptionsEquity),
+			MarketCboeETFOptions:    NewCboeOptions(OptionsETF),
+			MarketCboeIndexOptions:  NewCboeOptions(OptionsIndex),
+
+			MarketSSE:  NewChinaAShareExchange(ExchangeSSE),
+			MarketSZSE: NewChinaAShareExchange(ExchangeSZSE),
+
+			MarketChinaMainBoard: NewChinaAShareBoard(BoardMain),
+			MarketChinaSTAR:      NewChinaAShareBoard(BoardSTAR),
+			MarketChinaChiNext:   NewChinaAShareBoard(BoardChiNext),
+			MarketChinaBSE:       NewChinaAShareBoard(BoardBSE),
+
+			MarketStockConnectNorthbound: NewStockConnectNorthbound(),
+			MarketStockConnectSouthbound: NewStockConnectSouthbound(),
 		},
+		settlements: defaultSettlementConventions(),
+		clock:       realClock{},
+		symbols:     NewSymbolResolver(DefaultSymbolRules()...),
 	}
+	for _, futures := range futuresMarkets {
+		c.markets[futures.market] = NewChinaFutures(futures.group)
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Clock returns the clock used by the checker
+func (c *Checker) Clock() Clock {
+	return c.clock
+}
+
+// Now returns the current time according to the checker's clock
+func (c *Checker) Now() time.Time {
+	return c.clock.Now()
+}
+
+// IsOpenNow checks if the specified market is open at the current time of the checker's clock
+func (c *Checker) IsOpenNow(marketType MarketType) (bool, error) {
+	return c.IsOpen(marketType, c.clock.Now())
+}
+
+// GetStatusNow returns the status of the specified market at the current time of the checker's clock
+func (c *Checker) GetStatusNow(marketType MarketType) (MarketStatus, error) {
+	return c.GetStatus(marketType, c.clock.Now())
 }
 
 // IsOpen checks if the specified market is open at the given time
 func (c *Checker) IsOpen(marketType MarketType, t time.Time) (bool, error) {
-	market, ok := c.markets[marketType]
-	if !ok {
-		return false, fmt.Errorf("unknown market type: %s", marketType)
+	market, err := c.marketAt(marketType, t)
+	if err != nil {
+		return false, err
 	}
 	return market.IsOpen(t), nil
 }
 
 // GetStatus returns the status of the specified market at the given time
+// On error the returned status is empty rather than StatusClosed.
 func (c *Checker) GetStatus(marketType MarketType, t time.Time) (MarketStatus, error) {
-	market, ok := c.markets[marketType]
-	if !ok {
-		return StatusClosed, fmt.Errorf("unknown market type: %s", marketType)
+	market, err := c.marketAt(marketType, t)
+	if err != nil {
+		return "", err
 	}
 	return market.GetStatus(t), nil
 }
 
 // GetMarket returns the Market interface for the specified market type
+// Besides registered market types, MICs and aliases of built-in markets are accepted, e.g. "XHKG".
 func (c *Checker) GetMarket(marketType MarketType) (Market, error) {
-	market, ok := c.markets[marketType]
-	if !ok {
-		return nil, fmt.Errorf("unknown market type: %s", marketType)
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	resolved, err := c.resolve(marketType)
+	if err != nil {
+		return nil, err
+	}
+	return c.markets[resolved], nil
+}
+
+// marketAt returns the specified market after checking that its holiday data covers the given time
+func (c *Checker) marketAt(marketType MarketType, t time.Time) (Market, error) {
+	market, err := c.GetMarket(marketType)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkCoverage(market, t); err != nil {
+		return nil, err
 	}
 	return market, nil
 }
 
 // AddMarket allows adding a custom market to the checker
+// A market already registered under the market type is overwritten.
 func (c *Checker) AddMarket(marketType MarketType, market Market) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.markets[marketType] = market
+}
+
+// ReplaceMarket replaces the market registered under the specified market type
+// Returns an error if no market is registered under the market type.
+func (c *Checker) ReplaceMarket(marketType MarketType, market Market) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.markets[marketType]; !ok {
+		return &UnknownMarketError{Market: marketType}
+	}
 	c.markets[marketType] = market
+	return nil
+}
+
+// RemoveMarket removes the market registered under the specified market type
+// Returns an error if no market is registered under the market type.
+func (c *Checker) RemoveMarket(marketType MarketType) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.markets[marketType]; !ok {
+		return &UnknownMarketError{Market: marketType}
+	}
+	delete(c.markets, marketType)
+	return nil
+}
+
+// Markets returns the registered market types in sorted order
+func (c *Checker) Markets() []MarketType {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	types := make([]MarketType, 0, len(c.markets))
+	for marketType := range c.markets {
+		types = append(types, marketType)
+	}
+	sort.Slice(types, func(i, j int) bool {
+		return types[i] < types[j]
+	})
+	return types
 }
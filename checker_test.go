@@ -1,6 +1,7 @@
 package marketchecker
 
 import (
+	"sync"
 	"testing"
 	"time"
 )
@@ -88,6 +89,93 @@ func TestChecker_AddMarket(t *testing.T) {
 	}
 }
 
+func TestChecker_ReplaceRemoveMarket(t *testing.T) {
+	checker := NewChecker()
+
+	if err := checker.ReplaceMarket("CUSTOM", NewHKEX()); err == nil {
+		t.Error("Expected error replacing an unregistered market")
+	}
+	if err := checker.RemoveMarket("CUSTOM"); err == nil {
+		t.Error("Expected error removing an unregistered market")
+	}
+
+	checker.AddMarket("CUSTOM", NewNASDAQ())
+	if err := checker.ReplaceMarket("CUSTOM", NewHKEX()); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	market, err := checker.GetMarket("CUSTOM")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if market.Name() != "HKEX" {
+		t.Errorf("Expected replaced market HKEX, got %s", market.Name())
+	}
+
+	if err := checker.RemoveMarket("CUSTOM"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := checker.GetMarket("CUSTOM"); err == nil {
+		t.Error("Expected error for removed market")
+	}
+}
+
+func TestChecker_Markets(t *testing.T) {
+	checker := NewChecker()
+
+	markets := checker.Markets()
+	for i := 1; i < len(markets); i++ {
+		if markets[i-1] >= markets[i] {
+			t.Errorf("Expected sorted market types, got %s before %s", markets[i-1], markets[i])
+		}
+	}
+	for _, marketType := range []MarketType{MarketNASDAQ, MarketHKEX, MarketChinaAShare} {
+		found := false
+		for _, m := range markets {
+			found = found || m == marketType
+		}
+		if !found {
+			t.Errorf("Expected %s to be registered", marketType)
+		}
+	}
+
+	checker.AddMarket("CUSTOM", NewNASDAQ())
+	if got := len(checker.Markets()); got != len(markets)+1 {
+		t.Errorf("Expected %d markets after adding one, got %d", len(markets)+1, got)
+	}
+}
+
+// TestChecker_ConcurrentRegistry is meant to be run with the race detector (go test -race)
+func TestChecker_ConcurrentRegistry(t *testing.T) {
+	checker := NewChecker()
+	now := time.Date(2026, 1, 26, 15, 0, 0, 0, time.UTC)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				checker.AddMarket("CUSTOM", NewNASDAQ())
+				_ = checker.ReplaceMarket("CUSTOM", NewHKEX())
+				_ = checker.RemoveMarket("CUSTOM")
+				checker.RegisterSettlementConvention("CUSTOM", SettlementConvention{SecuritiesLag: 1})
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if _, err := checker.IsOpen(MarketNASDAQ, now); err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+				_, _ = checker.GetStatus("CUSTOM", now)
+				_, _ = checker.SettlementDate(MarketHKEX, now)
+				_ = checker.Markets()
+			}
+		}()
+	}
+	wg.Wait()
+}
+
 func TestChecker_MultipleMarkets(t *testing.T) {
 	checker := NewChecker()
 
@@ -4,10 +4,36 @@ import (
 	"time"
 )
 
+// ChinaBoard represents a listing board of the China A-Share market
+type ChinaBoard string
+
+const (
+	// BoardMain is the SSE and SZSE Main Board
+	BoardMain ChinaBoard = "Main Board"
+	// BoardSTAR is the SSE Science and Technology Innovation Board (STAR Market)
+	BoardSTAR ChinaBoard = "STAR Market"
+	// BoardChiNext is the SZSE ChiNext board
+	BoardChiNext ChinaBoard = "ChiNext"
+	// BoardBSE is the Beijing Stock Exchange
+	BoardBSE ChinaBoard = "Beijing Stock Exchange"
+)
+
+// ChinaExchange represents a mainland stock exchange of the China A-Share market
+type ChinaExchange string
+
+const (
+	// ExchangeSSE is the Shanghai Stock Exchange
+	ExchangeSSE ChinaExchange = "SSE"
+	// ExchangeSZSE is the Shenzhen Stock Exchange
+	ExchangeSZSE ChinaExchange = "SZSE"
+)
+
 // ChinaAShare represents the China A-Share market (SSE and SZSE)
 // Both Shanghai Stock Exchange and Shenzhen Stock Exchange have the same trading hours
 type ChinaAShare struct{
 	holidayProvider HolidayProvider
+	board           ChinaBoard
+	exchange        ChinaExchange
 }
 
 var (
@@ -16,24 +42,61 @@ var (
 )
 
 func init() {
-	var err error
-	chinaLocation, err = time.LoadLocation("Asia/Shanghai")
-	if err != nil {
-		// Fallback to UTC+8 if location loading fails
-		chinaLocation = time.FixedZone("CST", 8*3600)
-	}
+	chinaLocation = mustLoadLocation("Asia/Shanghai")
 }
 
 // NewChinaAShare creates a new China A-Share market instance
 func NewChinaAShare() *ChinaAShare {
 	return &ChinaAShare{
 		holidayProvider: NewStaticHolidayProvider(chinaAShareHolidays),
+		board:           BoardMain,
+	}
+}
+
+// NewChinaAShareBoard creates a new China A-Share market instance for a specific listing board
+func NewChinaAShareBoard(board ChinaBoard) *ChinaAShare {
+	return &ChinaAShare{
+		holidayProvider: NewStaticHolidayProvider(chinaAShareHolidays),
+		board:           board,
+	}
+}
+
+// NewChinaAShareExchange creates a new China A-Share market instance for a single exchange
+func NewChinaAShareExchange(exchange ChinaExchange) *ChinaAShare {
+	return &ChinaAShare{
+		holidayProvider: NewStaticHolidayProvider(chinaAShareHolidays),
+		board:           BoardMain,
+		exchange:        exchange,
 	}
 }
 
 // Name returns the market name
 func (c *ChinaAShare) Name() string {
-	return "China A-Share"
+	if c.exchange != "" {
+		return "China A-Share " + string(c.exchange)
+	}
+	if c.board == "" || c.board == BoardMain {
+		return "China A-Share"
+	}
+	return "China A-Share " + string(c.board)
+}
+
+// Location returns the market timezone
+func (c *ChinaAShare) Location() *time.Location {
+	return chinaLocation
+}
+
+// Board returns the listing board of the market
+func (c *ChinaAShare) Board() ChinaBoard {
+	if c.board == "" {
+		return BoardMain
+	}
+	return c.board
+}
+
+// Exchange returns the exchange of the market, or an empty string if it represents both SSE and SZSE
+func (c *ChinaAShare) Exchange() ChinaExchange {
+	return c.exchange
 }
 
 // IsOpen checks if China A-Share market is open for trading at the given time
@@ -44,17 +107,14 @@ func (c *ChinaAShare) IsOpen(t time.Time) bool {
 
 // GetStatus returns the current market status at the given time
 func (c *ChinaAShare) GetStatus(t time.Time) MarketStatus {
-	// Convert to China Standard Time
-	localTime := t.In(chinaLocation)
-
-	// Check if it's a holiday first
-	if c.holidayProvider != nil && c.holidayProvider.IsHoliday(localTime) {
-		return StatusClosed
-	}
+	return statusAt(c, t)
+}
 
-	// Check if it's weekend
-	if IsWeekend(localTime) {
-		return StatusClosed
+// SessionsOn returns the China A-Share trading sessions belonging to the given trading date
+func (c *ChinaAShare) SessionsOn(date time.Time) []Session {
+	day := startOfDay(date, chinaLocation)
+	if !c.IsTradingDay(day) {
+		return nil
 	}
 
 	// China A-Share trading hours (CST):
@@ -62,18 +122,53 @@ func (c *ChinaAShare) GetStatus(t time.Time) MarketStatus {
 	// Afternoon session: 1:00 PM - 3:00 PM
 
 	morningSession := TimeRange{
-		Start: 9*time.Hour + 30*time.Minute,
-		End:   11*time.Hour + 30*time.Minute,
+		Start: TimeOfDay{Hour: 9, Minute: 30},
+		End:   TimeOfDay{Hour: 11, Minute: 30},
 	}
 
 	afternoonSession := TimeRange{
-		Start: 13 * time.Hour,
-		End:   15 * time.Hour,
+		Start: TimeOfDay{Hour: 13},
+		End:   TimeOfDay{Hour: 15},
+	}
+
+	sessions := []Session{
+		newSession(c.Name(), "morning", StatusOpen, day, morningSession, day),
+		newSession(c.Name(), "afternoon", StatusOpen, day, afternoonSession, day),
 	}
 
-	if morningSession.IsWithin(localTime) || afternoonSession.IsWithin(localTime) {
-		return StatusOpen
+	// STAR Market, ChiNext and Beijing Stock Exchange run an after-hours
+	// fixed-price trading session: 3:05 PM - 3:30 PM
+	if c.hasAfterHoursSession() {
+		afterHoursSession := TimeRange{
+			Start: TimeOfDay{Hour: 15, Minute: 5},
+			End:   TimeOfDay{Hour: 15, Minute: 30},
+		}
+		sessions = append(sessions, newSession(c.Name(), "after-hours", StatusAfterHours, day, afterHoursSession, day))
 	}
 
-	return StatusClosed
+	return sessions
+}
+
+// hasAfterHoursSession checks if the board runs an after-hours fixed-price trading session
+func (c *ChinaAShare) hasAfterHoursSession() bool {
+	switch c.board {
+	case BoardSTAR, BoardChiNext, BoardBSE:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsTradingDay checks if the given date is a China A-Share trading day
+func (c *ChinaAShare) IsTradingDay(t time.Time) bool {
+	localTime := t.In(chinaLocation)
+	if c.holidayProvider != nil && c.holidayProvider.IsHoliday(localTime) {
+		return false
+	}
+	return !IsWeekend(localTime)
+}
+
+// Covers checks if the holiday data covers the given date
+func (c *ChinaAShare) Covers(t time.Time) bool {
+	return holidaysCover(c.holidayProvider, t.In(chinaLocation))
 }
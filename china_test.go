@@ -122,3 +122,57 @@ func TestChinaAShare_HolidayClosed(t *testing.T) {
 		t.Errorf("Expected status %s on National Day, got %s", StatusClosed, status)
 	}
 }
+
+func TestChinaAShare_AfterHoursFixedPriceSession(t *testing.T) {
+	loc, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+	afterHours := time.Date(2026, 1, 19, 15, 15, 0, 0, loc) // Monday 3:15 PM CST
+
+	tests := []struct {
+		board ChinaBoard
+		want  MarketStatus
+	}{
+		{BoardMain, StatusClosed},
+		{BoardSTAR, StatusAfterHours},
+		{BoardChiNext, StatusAfterHours},
+		{BoardBSE, StatusAfterHours},
+	}
+
+	for _, tt := range tests {
+		market := NewChinaAShareBoard(tt.board)
+		if status := market.GetStatus(afterHours); status != tt.want {
+			t.Errorf("%s: expected status %s at %v, got %s", tt.board, tt.want, afterHours, status)
+		}
+		if market.IsOpen(afterHours) {
+			t.Errorf("%s: regular trading should not be open at %v", tt.board, afterHours)
+		}
+	}
+
+	star := NewChinaAShareBoard(BoardSTAR)
+
+	// Gap between the close and the after-hours session
+	gap := time.Date(2026, 1, 19, 15, 2, 0, 0, loc)
+	if status := star.GetStatus(gap); status != StatusClosed {
+		t.Errorf("Expected status %s at %v, got %s", StatusClosed, gap, status)
+	}
+
+	// No after-hours session on holidays
+	holiday := time.Date(2026, 2, 17, 15, 15, 0, 0, loc)
+	if status := star.GetStatus(holiday); status != StatusClosed {
+		t.Errorf("Expected status %s on Spring Festival at %v, got %s", StatusClosed, holiday, status)
+	}
+}
+
+func TestChinaAShare_BoardName(t *testing.T) {
+	if name := NewChinaAShare().Name(); name != "China A-Share" {
+		t.Errorf("Expected name 'China A-Share', got '%s'", name)
+	}
+	if name := NewChinaAShareBoard(BoardSTAR).Name(); name != "China A-Share STAR Market" {
+		t.Errorf("Expected name 'China A-Share STAR Market', got '%s'", name)
+	}
+	if board := NewChinaAShare().Board(); board != BoardMain {
+		t.Errorf("Expected board %s, got %s", BoardMain, board)
+	}
+}
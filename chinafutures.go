@@ -0,0 +1,323 @@
+package marketchecker
+
+import (
+	"strings"
+	"time"
+)
+
+// FuturesExchange represents a Chinese futures exchange
+type FuturesExchange string
+
+const (
+	// ExchangeSHFE is the Shanghai Futures Exchange
+	ExchangeSHFE FuturesExchange = "SHFE"
+	// ExchangeDCE is the Dalian Commodity Exchange
+	ExchangeDCE FuturesExchange = "DCE"
+	// ExchangeZCE is the Zhengzhou Commodity Exchange
+	ExchangeZCE FuturesExchange = "ZCE"
+	// ExchangeCFFEX is the China Financial Futures Exchange
+	ExchangeCFFEX FuturesExchange = "CFFEX"
+	// ExchangeINE is the Shanghai International Energy Exchange
+	ExchangeINE FuturesExchange = "INE"
+)
+
+// FuturesProductGroup describes a group of futures products sharing the same trading hours
+type FuturesProductGroup struct {
+	Exchange FuturesExchange
+	Name     string
+	Products []string    // Product codes, e.g. "au", "cu", "IF"
+	Day      []TimeRange // Day trading sessions
+	Night    *TimeRange  // Night trading session, nil if the products have none
+}
+
+var (
+	// Commodity day sessions: 9:00 AM - 10:15 AM, 10:30 AM - 11:30 AM, 1:30 PM - 3:00 PM
+	commodityDaySessions = []TimeRange{
+		{Start: TimeOfDay{Hour: 9}, End: TimeOfDay{Hour: 10, Minute: 15}},
+		{Start: TimeOfDay{Hour: 10, Minute: 30}, End: TimeOfDay{Hour: 11, Minute: 30}},
+		{Start: TimeOfDay{Hour: 13, Minute: 30}, End: TimeOfDay{Hour: 15}},
+	}
+
+	// Night sessions start at 9:00 PM and end at 11:00 PM, 1:00 AM or 2:30 AM
+	nightUntil2300 = &TimeRange{Start: TimeOfDay{Hour: 21}, End: TimeOfDay{Hour: 23}}
+	nightUntil0100 = &TimeRange{Start: TimeOfDay{Hour: 21}, End: TimeOfDay{Hour: 1}}
+	nightUntil0230 = &TimeRange{Start: TimeOfDay{Hour: 21}, End: TimeOfDay{Hour: 2, Minute: 30}}
+)
+
+var (
+	// SHFEPreciousMetals are SHFE gold and silver futures
+	SHFEPreciousMetals = FuturesProductGroup{
+		Exchange: ExchangeSHFE,
+		Name:     "Precious Metals",
+		Products: []string{"au", "ag"},
+		Day:      commodityDaySessions,
+		Night:    nightUntil0230,
+	}
+	// SHFENonFerrousMetals are SHFE base metal futures
+	SHFENonFerrousMetals = FuturesProductGroup{
+		Exchange: ExchangeSHFE,
+		Name:     "Non-Ferrous Metals",
+		Products: []string{"cu", "al", "zn", "pb", "ni", "sn", "ao", "ss"},
+		Day:      commodityDaySessions,
+		Night:    nightUntil0100,
+	}
+	// SHFEOtherCommodities are SHFE ferrous, energy, rubber and pulp futures
+	SHFEOtherCommodities = FuturesProductGroup{
+		Exchange: ExchangeSHFE,
+		Name:     "Other Commodities",
+		Products: []string{"rb", "hc", "fu", "bu", "ru", "br", "sp"},
+		Day:      commodityDaySessions,
+		Night:    nightUntil2300,
+	}
+	// SHFEDayOnly are SHFE futures without a night session
+	SHFEDayOnly = FuturesProductGroup{
+		Exchange: ExchangeSHFE,
+		Name:     "Day Only",
+		Products: []string{"wr"},
+		Day:      commodityDaySessions,
+	}
+
+	// DCENightTrading are DCE futures with a night session
+	DCENightTrading = FuturesProductGroup{
+		Exchange: ExchangeDCE,
+		Name:     "Night Trading",
+		Products: []string{"a", "b", "m", "y", "p", "c", "cs", "rr", "i", "j", "jm", "l", "v", "pp", "eg", "eb", "pg"},
+		Day:      commodityDaySessions,
+		Night:    nightUntil2300,
+	}
+	// DCEDayOnly are DCE futures without a night session
+	DCEDayOnly = FuturesProductGroup{
+		Exchange: ExchangeDCE,
+		Name:     "Day Only",
+		Products: []string{"jd", "lh", "fb", "bb"},
+		Day:      commodityDaySessions,
+	}
+
+	// ZCENightTrading are ZCE futures with a night session
+	ZCENightTrading = FuturesProductGroup{
+		Exchange: ExchangeZCE,
+		Name:     "Night Trading",
+		Products: []string{"CF", "CY", "SR", "TA", "MA", "FG", "SA", "RM", "OI", "PF", "SH", "PX"},
+		Day:      commodityDaySessions,
+		Night:    nightUntil2300,
+	}
+	// ZCEDayOnly are ZCE futures without a night session
+	ZCEDayOnly = FuturesProductGroup{
+		Exchange: ExchangeZCE,
+		Name:     "Day Only",
+		Products: []string{"AP", "CJ", "PK", "WH"},
+		Day:      commodityDaySessions,
+	}
+
+	// INECrudeOil are INE crude oil futures
+	INECrudeOil = FuturesProductGroup{
+		Exchange: ExchangeINE,
+		Name:     "Crude Oil",
+		Products: []string{"sc"},
+		Day:      commodityDaySessions,
+		Night:    nightUntil0230,
+	}
+	// INEInternationalCopper are INE international copper futures
+	INEInternationalCopper = FuturesProductGroup{
+		Exchange: ExchangeINE,
+		Name:     "International Copper",
+		Products: []string{"bc"},
+		Day:      commodityDaySessions,
+		Night:    nightUntil0100,
+	}
+	// INEOtherCommodities are INE low sulfur fuel oil and TSR 20 rubber futures
+	INEOtherCommodities = FuturesProductGroup{
+		Exchange: ExchangeINE,
+		Name:     "Other Commodities",
+		Products: []string{"lu", "nr"},
+		Day:      commodityDaySessions,
+		Night:    nightUntil2300,
+	}
+	// INEDayOnly are INE futures without a night session
+	INEDayOnly = FuturesProductGroup{
+		Exchange: ExchangeINE,
+		Name:     "Day Only",
+		Products: []string{"ec"},
+		Day:      commodityDaySessions,
+	}
+
+	// CFFEXEquityIndex are CFFEX stock index futures
+	CFFEXEquityIndex = FuturesProductGroup{
+		Exchange: ExchangeCFFEX,
+		Name:     "Equity Index",
+		Products: []string{"IF", "IH", "IC", "IM"},
+		Day: []TimeRange{
+			{Start: TimeOfDay{Hour: 9, Minute: 30}, End: TimeOfDay{Hour: 11, Minute: 30}},
+			{Start: TimeOfDay{Hour: 13}, End: TimeOfDay{Hour: 15}},
+		},
+	}
+	// CFFEXTreasury are CFFEX treasury bond futures
+	CFFEXTreasury = FuturesProductGroup{
+		Exchange: ExchangeCFFEX,
+		Name:     "Treasury",
+		Products: []string{"TS", "TF", "T", "TL"},
+		Day: []TimeRange{
+			{Start: TimeOfDay{Hour: 9, Minute: 30}, End: TimeOfDay{Hour: 11, Minute: 30}},
+			{Start: TimeOfDay{Hour: 13}, End: TimeOfDay{Hour: 15, Minute: 15}},
+		},
+	}
+)
+
+// futuresProductGroups lists all predefined product groups for product lookup
+var futuresProductGroups = []FuturesProductGroup{
+	SHFEPreciousMetals, SHFENonFerrousMetals, SHFEOtherCommodities, SHFEDayOnly,
+	DCENightTrading, DCEDayOnly,
+	ZCENightTrading, ZCEDayOnly,
+	INECrudeOil, INEInternationalCopper, INEOtherCommodities, INEDayOnly,
+	CFFEXEquityIndex, CFFEXTreasury,
+}
+
+// LookupFuturesProductGroup returns the predefined product group of a futures product code
+// The lookup is case-insensitive, e.g. "au", "CU" or "if"
+func LookupFuturesProductGroup(product string) (FuturesProductGroup, bool) {
+	for _, group := range futuresProductGroups {
+		for _, p := range group.Products {
+			if strings.EqualFold(p, product) {
+				return group, true
+			}
+		}
+	}
+	return FuturesProductGroup{}, false
+}
+
+// futuresMarkets lists the market type registered in the Checker for each predefined product group
+var futuresMarkets = []struct {
+	market MarketType
+	group  FuturesProductGroup
+}{
+	{MarketSHFEPreciousMetals, SHFEPreciousMetals},
+	{MarketSHFENonFerrousMetals, SHFENonFerrousMetals},
+	{MarketSHFEOtherCommodities, SHFEOtherCommodities},
+	{MarketSHFEDayOnly, SHFEDayOnly},
+	{MarketDCENightTrading, DCENightTrading},
+	{MarketDCEDayOnly, DCEDayOnly},
+	{MarketZCENightTrading, ZCENightTrading},
+	{MarketZCEDayOnly, ZCEDayOnly},
+	{MarketCFFEXEquityIndex, CFFEXEquityIndex},
+	{MarketCFFEXTreasury, CFFEXTreasury},
+	{MarketINECrudeOil, INECrudeOil},
+	{MarketINEInternationalCopper, INEInternationalCopper},
+	{MarketINEOtherCommodities, INEOtherCommodities},
+	{MarketINEDayOnly, INEDayOnly},
+}
+
+// FuturesMarketType returns the market type registered in the Checker for a futures product code
+// The lookup is case-insensitive, e.g. "au" returns MarketSHFEPreciousMetals.
+func FuturesMarketType(product string) (MarketType, bool) {
+	group, ok := LookupFuturesProductGroup(product)
+	if !ok {
+		return "", false
+	}
+	for _, futures := range futuresMarkets {
+		if futures.group.Exchange == group.Exchange && futures.group.Name == group.Name {
+			return futures.market, true
+		}
+	}
+	return "", false
+}
+
+// ChinaFutures represents a product group traded on a Chinese futures exchange
+//
+// Night sessions are held on the evening of a trading day and belong to the
+// next trading day. There is no night session before a statutory holiday.
+type ChinaFutures struct {
+	group           FuturesProductGroup
+	holidayProvider HolidayProvider
+}
+
+// NewChinaFutures creates a new Chinese futures market instance for a product group
+func NewChinaFutures(group FuturesProductGroup) *ChinaFutures {
+	return &ChinaFutures{
+		group:           group,
+		holidayProvider: NewStaticHolidayProvider(chinaAShareHolidays),
+	}
+}
+
+// Name returns the market name
+func (f *ChinaFutures) Name() string {
+	return string(f.group.Exchange) + " " + f.group.Name
+}
+
+// Location returns the market timezone
+func (f *ChinaFutures) Location() *time.Location {
+	return chinaLocation
+}
+
+// ProductGroup returns the product group traded in this market
+func (f *ChinaFutures) ProductGroup() FuturesProductGroup {
+	return f.group
+}
+
+// IsOpen checks if the day session is open at the given time
+func (f *ChinaFutures) IsOpen(t time.Time) bool {
+	status := f.GetStatus(t)
+	return status == StatusOpen
+}
+
+// GetStatus returns the current market status at the given time
+// The day session is reported as open and the night session as overnight.
+func (f *ChinaFutures) GetStatus(t time.Time) MarketStatus {
+	return statusAt(f, t)
+}
+
+// SessionsOn returns the trading sessions of the product group belonging to the given trading date
+// The night session held on the evening of the previous trading day belongs to the trading date.
+func (f *ChinaFutures) SessionsOn(date time.Time) []Session {
+	day := startOfDay(date, chinaLocation)
+	if !f.IsTradingDay(day) {
+		return nil
+	}
+
+	var sessions []Session
+	if f.group.Night != nil {
+		if previous, ok := f.nightSessionDay(day); ok {
+			sessions = append(sessions, newSession(f.Name(), "night", StatusOvernight, previous, *f.group.Night, day))
+		}
+	}
+
+	for _, session := range f.group.Day {
+		name := "morning"
+		if !session.Start.Before(TimeOfDay{Hour: 12}) {
+			name = "afternoon"
+		}
+		sessions = append(sessions, newSession(f.Name(), name, StatusOpen, day, session, day))
+	}
+	return sessions
+}
+
+// nightSessionDay returns the previous trading day whose evening night session belongs to the given trading date
+// Returns false if no night session is held, which is the case after a holiday.
+func (f *ChinaFutures) nightSessionDay(day time.Time) (time.Time, bool) {
+	previous, err := stepTradingDay(f, day, -1)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	// No night session before a holiday: only weekend days may lie between
+	// the previous trading day and the trading date
+	for between := previous.AddDate(0, 0, 1); between.Before(day); between = between.AddDate(0, 0, 1) {
+		if f.holidayProvider != nil && f.holidayProvider.IsHoliday(between) {
+			return time.Time{}, false
+		}
+	}
+	return previous, true
+}
+
+// IsTradingDay checks if the given date is a Chinese futures trading day
+func (f *ChinaFutures) IsTradingDay(t time.Time) bool {
+	localTime := t.In(chinaLocation)
+	if f.holidayProvider != nil && f.holidayProvider.IsHoliday(localTime) {
+		return false
+	}
+	return !IsWeekend(localTime)
+}
+
+// Covers checks if the holiday data covers the given date
+func (f *ChinaFutures) Covers(t time.Time) bool {
+	return holidaysCover(f.holidayProvider, t.In(chinaLocation))
+}
@@ -0,0 +1,241 @@
+package marketchecker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestChinaFutures_DaySession(t *testing.T) {
+	gold := NewChinaFutures(SHFEPreciousMetals)
+
+	loc, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	tests := []struct {
+		desc string
+		time time.Time
+		want MarketStatus
+	}{
+		{"Before the open", time.Date(2026, 1, 19, 8, 55, 0, 0, loc), StatusClosed},
+		{"First session", time.Date(2026, 1, 19, 9, 5, 0, 0, loc), StatusOpen},
+		{"Morning break", time.Date(2026, 1, 19, 10, 20, 0, 0, loc), StatusClosed},
+		{"Second session", time.Date(2026, 1, 19, 10, 45, 0, 0, loc), StatusOpen},
+		{"Lunch break", time.Date(2026, 1, 19, 13, 0, 0, 0, loc), StatusClosed},
+		{"Afternoon session", time.Date(2026, 1, 19, 14, 0, 0, 0, loc), StatusOpen},
+		{"After the close", time.Date(2026, 1, 19, 15, 30, 0, 0, loc), StatusClosed},
+		{"Saturday", time.Date(2026, 1, 24, 10, 0, 0, 0, loc), StatusClosed},
+	}
+
+	for _, tt := range tests {
+		if status := gold.GetStatus(tt.time); status != tt.want {
+			t.Errorf("%s: expected status %s at %v, got %s", tt.desc, tt.want, tt.time, status)
+		}
+	}
+}
+
+func TestChinaFutures_NightSessionByProductGroup(t *testing.T) {
+	loc, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	tests := []struct {
+		group FuturesProductGroup
+		time  time.Time
+		want  MarketStatus
+	}{
+		{SHFEPreciousMetals, time.Date(2026, 1, 19, 21, 30, 0, 0, loc), StatusOvernight},
+		{SHFEPreciousMetals, time.Date(2026, 1, 20, 2, 0, 0, 0, loc), StatusOvernight},
+		{SHFENonFerrousMetals, time.Date(2026, 1, 20, 0, 30, 0, 0, loc), StatusOvernight},
+		{SHFENonFerrousMetals, time.Date(2026, 1, 20, 2, 0, 0, 0, loc), StatusClosed},
+		{DCENightTrading, time.Date(2026, 1, 19, 22, 30, 0, 0, loc), StatusOvernight},
+		{DCENightTrading, time.Date(2026, 1, 19, 23, 30, 0, 0, loc), StatusClosed},
+		{ZCEDayOnly, time.Date(2026, 1, 19, 21, 30, 0, 0, loc), StatusClosed},
+		{CFFEXEquityIndex, time.Date(2026, 1, 19, 21, 30, 0, 0, loc), StatusClosed},
+	}
+
+	for _, tt := range tests {
+		market := NewChinaFutures(tt.group)
+		if status := market.GetStatus(tt.time); status != tt.want {
+			t.Errorf("%s: expected status %s at %v, got %s", market.Name(), tt.want, tt.time, status)
+		}
+	}
+}
+
+func TestChinaFutures_CFFEX(t *testing.T) {
+	loc, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	equityIndex := NewChinaFutures(CFFEXEquityIndex)
+	treasury := NewChinaFutures(CFFEXTreasury)
+
+	// No morning break for financial futures
+	morning := time.Date(2026, 1, 19, 10, 20, 0, 0, loc)
+	if !equityIndex.IsOpen(morning) {
+		t.Errorf("CFFEX equity index futures should be open at %v", morning)
+	}
+
+	// Treasury futures trade until 3:15 PM
+	lateAfternoon := time.Date(2026, 1, 19, 15, 10, 0, 0, loc)
+	if equityIndex.IsOpen(lateAfternoon) {
+		t.Errorf("CFFEX equity index futures should be closed at %v", lateAfternoon)
+	}
+	if !treasury.IsOpen(lateAfternoon) {
+		t.Errorf("CFFEX treasury futures should be open at %v", lateAfternoon)
+	}
+}
+
+func TestChinaFutures_NoNightSessionBeforeHoliday(t *testing.T) {
+	gold := NewChinaFutures(SHFEPreciousMetals)
+
+	loc, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	// Friday night sessions run over an ordinary weekend
+	fridayNight := time.Date(2026, 1, 23, 22, 0, 0, 0, loc)
+	if status := gold.GetStatus(fridayNight); status != StatusOvernight {
+		t.Errorf("Expected status %s at %v, got %s", StatusOvernight, fridayNight, status)
+	}
+	saturdayMorning := time.Date(2026, 1, 24, 1, 0, 0, 0, loc)
+	if status := gold.GetStatus(saturdayMorning); status != StatusOvernight {
+		t.Errorf("Expected status %s at %v, got %s", StatusOvernight, saturdayMorning, status)
+	}
+
+	// Feb 12, 2026: the next trading day is Feb 13, night session is held
+	thursdayNight := time.Date(2026, 2, 12, 22, 0, 0, 0, loc)
+	if status := gold.GetStatus(thursdayNight); status != StatusOvernight {
+		t.Errorf("Expected status %s at %v, got %s", StatusOvernight, thursdayNight, status)
+	}
+
+	// Feb 13, 2026: last trading day before Spring Festival, no night session
+	beforeSpringFestival := time.Date(2026, 2, 13, 22, 0, 0, 0, loc)
+	if status := gold.GetStatus(beforeSpringFestival); status != StatusClosed {
+		t.Errorf("Expected status %s at %v, got %s", StatusClosed, beforeSpringFestival, status)
+	}
+	if status := gold.GetStatus(beforeSpringFestival.Add(4 * time.Hour)); status != StatusClosed {
+		t.Errorf("Expected status %s at %v, got %s", StatusClosed, beforeSpringFestival.Add(4*time.Hour), status)
+	}
+}
+
+func TestChinaFutures_TradingDate(t *testing.T) {
+	gold := NewChinaFutures(SHFEPreciousMetals)
+
+	loc, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	tests := []struct {
+		desc string
+		time time.Time
+		want time.Time
+	}{
+		{"Day session", time.Date(2026, 1, 19, 10, 0, 0, 0, loc), time.Date(2026, 1, 19, 0, 0, 0, 0, loc)},
+		{"Monday night session", time.Date(2026, 1, 19, 21, 30, 0, 0, loc), time.Date(2026, 1, 20, 0, 0, 0, 0, loc)},
+		{"Monday night session after midnight", time.Date(2026, 1, 20, 1, 30, 0, 0, loc), time.Date(2026, 1, 20, 0, 0, 0, 0, loc)},
+		{"Friday night session", time.Date(2026, 1, 23, 22, 0, 0, 0, loc), time.Date(2026, 1, 26, 0, 0, 0, 0, loc)},
+	}
+
+	for _, tt := range tests {
+		got, ok := tradingDateAt(gold, tt.time)
+		if !ok {
+			t.Errorf("%s: expected a trading date for %v", tt.desc, tt.time)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("%s: expected trading date %v, got %v", tt.desc, tt.want, got)
+		}
+	}
+
+	if _, ok := tradingDateAt(gold, time.Date(2026, 1, 19, 16, 0, 0, 0, loc)); ok {
+		t.Error("Expected no trading date between the day and night sessions")
+	}
+}
+
+func TestLookupFuturesProductGroup(t *testing.T) {
+	tests := []struct {
+		product string
+		want    string
+	}{
+		{"au", "SHFE Precious Metals"},
+		{"CU", "SHFE Non-Ferrous Metals"},
+		{"sc", "INE Crude Oil"},
+		{"if", "CFFEX Equity Index"},
+		{"AP", "ZCE Day Only"},
+	}
+
+	for _, tt := range tests {
+		group, ok := LookupFuturesProductGroup(tt.product)
+		if !ok {
+			t.Errorf("Expected product group for %q", tt.product)
+			continue
+		}
+		if name := NewChinaFutures(group).Name(); name != tt.want {
+			t.Errorf("Product %q: expected %s, got %s", tt.product, tt.want, name)
+		}
+	}
+
+	if _, ok := LookupFuturesProductGroup("unknown"); ok {
+		t.Error("Expected no product group for unknown product")
+	}
+}
+
+func TestFuturesMarketType(t *testing.T) {
+	checker := NewChecker()
+
+	loc, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+	// 1:30 AM on Tuesday: only the night session until 2:30 AM is still running
+	night := time.Date(2026, 1, 20, 1, 30, 0, 0, loc)
+
+	tests := []struct {
+		product    string
+		wantMarket MarketType
+		wantStatus MarketStatus
+	}{
+		{"au", MarketSHFEPreciousMetals, StatusOvernight},
+		{"CU", MarketSHFENonFerrousMetals, StatusClosed},
+		{"rb", MarketSHFEOtherCommodities, StatusClosed},
+		{"sc", MarketINECrudeOil, StatusOvernight},
+		{"T", MarketCFFEXTreasury, StatusClosed},
+	}
+
+	for _, tt := range tests {
+		market, ok := FuturesMarketType(tt.product)
+		if !ok || market != tt.wantMarket {
+			t.Errorf("Product %q: expected %s, got %s (ok=%v)", tt.product, tt.wantMarket, market, ok)
+			continue
+		}
+		status, err := checker.GetStatus(market, night)
+		if err != nil {
+			t.Fatalf("Product %q: unexpected error: %v", tt.product, err)
+		}
+		if status != tt.wantStatus {
+			t.Errorf("Product %q: expected %s, got %s", tt.product, tt.wantStatus, status)
+		}
+	}
+
+	if _, ok := FuturesMarketType("unknown"); ok {
+		t.Error("Expected no market type for unknown product")
+	}
+
+	// Every predefined product group is registered
+	for _, group := range futuresProductGroups {
+		market, ok := FuturesMarketType(group.Products[0])
+		if !ok {
+			t.Errorf("%s %s: no market type", group.Exchange, group.Name)
+			continue
+		}
+		if _, err := checker.GetMarket(market); err != nil {
+			t.Errorf("%s: %v", market, err)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package marketchecker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFakeClock_Timers(t *testing.T) {
+	start := time.Date(2026, 1, 26, 9, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+
+	early := clock.NewTimer(time.Minute)
+	late := clock.After(time.Hour)
+	stopped := clock.NewTimer(30 * time.Minute)
+
+	if !stopped.Stop() {
+		t.Error("Expected Stop to report a pending timer")
+	}
+	if stopped.Stop() {
+		t.Error("Expected second Stop to report an inactive timer")
+	}
+
+	clock.Advance(10 * time.Minute)
+	if !clock.Now().Equal(start.Add(10 * time.Minute)) {
+		t.Errorf("Expected clock to advance by 10m, got %v", clock.Now())
+	}
+
+	select {
+	case at := <-early.C():
+		// Timers receive their deadline rather than the advanced time
+		if !at.Equal(start.Add(time.Minute)) {
+			t.Errorf("Expected timer to fire at %v, got %v", start.Add(time.Minute), at)
+		}
+	default:
+		t.Error("Expected due timer to fire")
+	}
+	select {
+	case <-late:
+		t.Error("Expected pending timer not to fire")
+	case <-stopped.C():
+		t.Error("Expected stopped timer not to fire")
+	default:
+	}
+
+	clock.Set(start.Add(2 * time.Hour))
+	select {
+	case <-late:
+	default:
+		t.Error("Expected timer to fire after setting the clock")
+	}
+}
+
+func TestFakeClock_Reset(t *testing.T) {
+	clock := NewFakeClock(time.Date(2026, 1, 26, 9, 0, 0, 0, time.UTC))
+
+	timer := clock.NewTimer(time.Hour)
+	if !timer.Reset(time.Minute) {
+		t.Error("Expected Reset to report a pending timer")
+	}
+	clock.Advance(time.Minute)
+	select {
+	case <-timer.C():
+	default:
+		t.Error("Expected reset timer to fire")
+	}
+
+	immediate := clock.NewTimer(0)
+	select {
+	case <-immediate.C():
+	default:
+		t.Error("Expected timer with zero duration to fire immediately")
+	}
+}
+
+func TestFakeClock_BlockUntil(t *testing.T) {
+	clock := NewFakeClock(time.Date(2026, 1, 26, 9, 0, 0, 0, time.UTC))
+
+	done := make(chan time.Time)
+	go func() {
+		done <- <-clock.After(time.Second)
+	}()
+
+	clock.BlockUntil(1)
+	clock.Advance(time.Second)
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("Expected waiting goroutine to wake up")
+	}
+}
+
+func TestChecker_Now(t *testing.T) {
+	hk, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	clock := NewFakeClock(time.Date(2026, 1, 26, 10, 0, 0, 0, hk))
+	checker := NewChecker(WithClock(clock))
+
+	open, err := checker.IsOpenNow(MarketHKEX)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !open {
+		t.Error("Expected HKEX to be open at 10:00")
+	}
+
+	clock.Advance(2 * time.Hour)
+	status, err := checker.GetStatusNow(MarketHKEX)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if status != StatusClosed {
+		t.Errorf("Expected HKEX to be closed at lunch, got %s", status)
+	}
+	if !checker.Now().Equal(clock.Now()) {
+		t.Errorf("Expected checker time %v, got %v", clock.Now(), checker.Now())
+	}
+}
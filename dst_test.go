@@ -0,0 +1,148 @@
+package marketchecker
+
+import (
+	"testing"
+	"time"
+)
+
+// DST in America/New_York starts on Sunday 2026-03-08 (23-hour day) and ends on Sunday 2026-11-01 (25-hour day).
+
+func TestTimeRange_IsWithinDSTTransitions(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	overnight := TimeRange{Start: TimeOfDay{Hour: 20}, End: TimeOfDay{Hour: 4}}
+	regular := TimeRange{Start: TimeOfDay{Hour: 9, Minute: 30}, End: TimeOfDay{Hour: 16}}
+
+	tests := []struct {
+		desc string
+		tr   TimeRange
+		time time.Time
+		want bool
+	}{
+		{"March: just before 20:00", overnight, time.Date(2026, 3, 8, 19, 59, 0, 0, loc), false},
+		{"March: 20:00", overnight, time.Date(2026, 3, 8, 20, 0, 0, 0, loc), true},
+		{"March: 20:30", overnight, time.Date(2026, 3, 8, 20, 30, 0, 0, loc), true},
+		{"March: 03:59 after the change", overnight, time.Date(2026, 3, 8, 3, 59, 0, 0, loc), true},
+		{"March: 04:00 after the change", overnight, time.Date(2026, 3, 8, 4, 0, 0, 0, loc), false},
+		{"March: 09:30", regular, time.Date(2026, 3, 8, 9, 30, 0, 0, loc), true},
+		{"March: 09:29", regular, time.Date(2026, 3, 8, 9, 29, 0, 0, loc), false},
+		{"November: 19:00", overnight, time.Date(2026, 11, 1, 19, 0, 0, 0, loc), false},
+		{"November: 19:59", overnight, time.Date(2026, 11, 1, 19, 59, 0, 0, loc), false},
+		{"November: 20:00", overnight, time.Date(2026, 11, 1, 20, 0, 0, 0, loc), true},
+		{"November: 04:00 after the change", overnight, time.Date(2026, 11, 1, 4, 0, 0, 0, loc), false},
+		{"November: 03:30 after the change", overnight, time.Date(2026, 11, 1, 3, 30, 0, 0, loc), true},
+		{"November: 15:59", regular, time.Date(2026, 11, 1, 15, 59, 0, 0, loc), true},
+		{"November: 16:00", regular, time.Date(2026, 11, 1, 16, 0, 0, 0, loc), false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.tr.IsWithin(tt.time); got != tt.want {
+			t.Errorf("%s: expected %v, got %v", tt.desc, tt.want, got)
+		}
+	}
+}
+
+func TestNASDAQ_OvernightAcrossDSTTransitions(t *testing.T) {
+	nasdaq := NewNASDAQ()
+
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	tests := []struct {
+		desc string
+		time time.Time
+		want MarketStatus
+	}{
+		// Sunday evening overnight sessions open at 20:00 wall-clock time on both change days
+		{"March Sunday 19:59", time.Date(2026, 3, 8, 19, 59, 0, 0, loc), StatusClosed},
+		{"March Sunday 20:00", time.Date(2026, 3, 8, 20, 0, 0, 0, loc), StatusOvernight},
+		{"March Monday 03:59", time.Date(2026, 3, 9, 3, 59, 0, 0, loc), StatusOvernight},
+		{"March Monday 04:00", time.Date(2026, 3, 9, 4, 0, 0, 0, loc), StatusPremarket},
+		{"March Monday 09:30", time.Date(2026, 3, 9, 9, 30, 0, 0, loc), StatusOpen},
+		{"March Friday before 20:00", time.Date(2026, 3, 6, 19, 59, 0, 0, loc), StatusPostmarket},
+		{"November Sunday 19:00", time.Date(2026, 11, 1, 19, 0, 0, 0, loc), StatusClosed},
+		{"November Sunday 19:59", time.Date(2026, 11, 1, 19, 59, 0, 0, loc), StatusClosed},
+		{"November Sunday 20:00", time.Date(2026, 11, 1, 20, 0, 0, 0, loc), StatusOvernight},
+		{"November Monday 03:59", time.Date(2026, 11, 2, 3, 59, 0, 0, loc), StatusOvernight},
+		{"November Monday 04:00", time.Date(2026, 11, 2, 4, 0, 0, 0, loc), StatusPremarket},
+		// The Friday before the change still runs on daylight time
+		{"October Friday 19:59", time.Date(2026, 10, 30, 19, 59, 0, 0, loc), StatusPostmarket},
+		{"October Friday 20:00", time.Date(2026, 10, 30, 20, 0, 0, 0, loc), StatusClosed},
+	}
+
+	for _, tt := range tests {
+		if got := nasdaq.GetStatus(tt.time); got != tt.want {
+			t.Errorf("%s: expected %s, got %s", tt.desc, tt.want, got)
+		}
+	}
+}
+
+func TestNASDAQ_OvernightSessionUTCBoundariesAcrossDST(t *testing.T) {
+	nasdaq := NewNASDAQ()
+
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	tests := []struct {
+		desc      string
+		date      time.Time
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		// Sunday 20:00 EST before the March change would be 01:00 UTC; it is daylight time already
+		{"March", time.Date(2026, 3, 9, 0, 0, 0, 0, loc), time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC), time.Date(2026, 3, 9, 8, 0, 0, 0, time.UTC)},
+		{"November", time.Date(2026, 11, 2, 0, 0, 0, 0, loc), time.Date(2026, 11, 2, 1, 0, 0, 0, time.UTC), time.Date(2026, 11, 2, 9, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		sessions := nasdaq.SessionsOn(tt.date)
+		if len(sessions) == 0 || sessions[0].Name != "overnight" {
+			t.Fatalf("%s: expected an overnight session first, got %v", tt.desc, sessions)
+		}
+		overnight := sessions[0]
+		if !overnight.Start.Equal(tt.wantStart) || !overnight.End.Equal(tt.wantEnd) {
+			t.Errorf("%s: expected %v - %v, got %v - %v", tt.desc, tt.wantStart, tt.wantEnd, overnight.Start.UTC(), overnight.End.UTC())
+		}
+		if d := overnight.End.Sub(overnight.Start); d != 8*time.Hour {
+			t.Errorf("%s: expected an 8h overnight session, got %v", tt.desc, d)
+		}
+	}
+}
+
+func TestChecker_TradingDurationAcrossDST(t *testing.T) {
+	checker := NewChecker()
+
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	// Weeks containing the transitions have the same amount of trading in wall-clock terms
+	for _, monday := range []time.Time{time.Date(2026, 3, 9, 0, 0, 0, 0, loc), time.Date(2026, 11, 2, 0, 0, 0, 0, loc)} {
+		from := monday.AddDate(0, 0, -2)
+		to := monday.AddDate(0, 0, 5)
+
+		regular, err := checker.TradingDuration(MarketNASDAQ, from, to)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if want := 5 * (6*time.Hour + 30*time.Minute); regular != want {
+			t.Errorf("Week of %s: expected %v of regular trading, got %v", monday.Format("2006-01-02"), want, regular)
+		}
+
+		overnight, err := checker.TradingDuration(MarketNASDAQ, from, to, StatusOvernight)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if want := 5 * 8 * time.Hour; overnight != want {
+			t.Errorf("Week of %s: expected %v of overnight trading, got %v", monday.Format("2006-01-02"), want, overnight)
+		}
+	}
+}
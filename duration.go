@@ -0,0 +1,59 @@
+package marketchecker
+
+import (
+	"time"
+)
+
+// TradingDuration returns the time within [from, to) the specified market spends in any of the given statuses
+// Without statuses only regular trading (StatusOpen) is counted. Lunch breaks,
+// weekends and holidays are excluded because no session covers them.
+func (c *Checker) TradingDuration(marketType MarketType, from, to time.Time, statuses ...MarketStatus) (time.Duration, error) {
+	schedule, err := c.sessionSchedule(marketType, from, to)
+	if err != nil {
+		return 0, err
+	}
+	return tradingDuration(schedule, from, to, statuses), nil
+}
+
+// RemainingInSession returns the time left in the session of the specified market in progress at t
+// The boolean result is false if the market is closed at t.
+func (c *Checker) RemainingInSession(marketType MarketType, t time.Time) (time.Duration, bool, error) {
+	schedule, err := c.sessionSchedule(marketType, t, t)
+	if err != nil {
+		return 0, false, err
+	}
+	remaining, ok := remainingInSession(schedule, t)
+	return remaining, ok, nil
+}
+
+// tradingDuration returns the time within [from, to) the schedule spends in any of the given statuses
+func tradingDuration(schedule SessionSchedule, from, to time.Time, statuses []MarketStatus) time.Duration {
+	if len(statuses) == 0 {
+		statuses = []MarketStatus{StatusOpen}
+	}
+
+	var total time.Duration
+	for _, window := range statusWindows(schedule, from, to, statuses) {
+		total += window.Duration()
+	}
+	return total
+}
+
+// remainingInSession returns the time left in the session of the schedule in progress at t
+func remainingInSession(schedule SessionSchedule, t time.Time) (time.Duration, bool) {
+	session, ok := sessionAt(schedule, t)
+	if !ok {
+		return 0, false
+	}
+	return session.End.Sub(t), true
+}
+
+// containsStatus checks if status is one of statuses
+func containsStatus(statuses []MarketStatus, status MarketStatus) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
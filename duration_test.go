@@ -0,0 +1,126 @@
+package marketchecker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHKEX_TradingDuration(t *testing.T) {
+	hkex := NewHKEX()
+
+	loc, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	tests := []struct {
+		desc     string
+		from, to time.Time
+		statuses []MarketStatus
+		want     time.Duration
+	}{
+		{"full day excludes lunch break", time.Date(2026, 1, 26, 0, 0, 0, 0, loc), time.Date(2026, 1, 27, 0, 0, 0, 0, loc), nil, 5*time.Hour + 30*time.Minute},
+		{"range clipped to sessions", time.Date(2026, 1, 26, 11, 0, 0, 0, loc), time.Date(2026, 1, 26, 14, 0, 0, 0, loc), nil, 2 * time.Hour},
+		{"lunch break only", time.Date(2026, 1, 26, 12, 0, 0, 0, loc), time.Date(2026, 1, 26, 13, 0, 0, 0, loc), nil, 0},
+		{"week excludes weekend", time.Date(2026, 1, 26, 0, 0, 0, 0, loc), time.Date(2026, 2, 2, 0, 0, 0, 0, loc), nil, 5 * (5*time.Hour + 30*time.Minute)},
+		{"half day has morning session only", time.Date(2026, 12, 24, 0, 0, 0, 0, loc), time.Date(2026, 12, 25, 0, 0, 0, 0, loc), nil, 2*time.Hour + 30*time.Minute},
+		{"holiday", time.Date(2026, 1, 1, 0, 0, 0, 0, loc), time.Date(2026, 1, 2, 0, 0, 0, 0, loc), nil, 0},
+		{"empty range", time.Date(2026, 1, 26, 14, 0, 0, 0, loc), time.Date(2026, 1, 26, 10, 0, 0, 0, loc), nil, 0},
+	}
+
+	for _, tt := range tests {
+		if got := tradingDuration(hkex, tt.from, tt.to, tt.statuses); got != tt.want {
+			t.Errorf("%s: expected %v, got %v", tt.desc, tt.want, got)
+		}
+	}
+}
+
+func TestNASDAQ_TradingDurationStatuses(t *testing.T) {
+	nasdaq := NewNASDAQ()
+
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	from := time.Date(2026, 1, 27, 0, 0, 0, 0, loc)
+	to := time.Date(2026, 1, 28, 0, 0, 0, 0, loc)
+
+	tests := []struct {
+		desc     string
+		statuses []MarketStatus
+		want     time.Duration
+	}{
+		{"regular only by default", nil, 6*time.Hour + 30*time.Minute},
+		{"premarket", []MarketStatus{StatusPremarket}, 5*time.Hour + 30*time.Minute},
+		{"regular and postmarket", []MarketStatus{StatusOpen, StatusPostmarket}, 10*time.Hour + 30*time.Minute},
+	}
+
+	for _, tt := range tests {
+		if got := tradingDuration(nasdaq, from, to, tt.statuses); got != tt.want {
+			t.Errorf("%s: expected %v, got %v", tt.desc, tt.want, got)
+		}
+	}
+}
+
+func TestHKEX_RemainingInSession(t *testing.T) {
+	hkex := NewHKEX()
+
+	loc, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	tests := []struct {
+		desc   string
+		time   time.Time
+		want   time.Duration
+		wantOK bool
+	}{
+		{"morning session", time.Date(2026, 1, 26, 11, 15, 0, 0, loc), 45 * time.Minute, true},
+		{"afternoon session", time.Date(2026, 1, 26, 15, 30, 0, 0, loc), 30 * time.Minute, true},
+		{"lunch break", time.Date(2026, 1, 26, 12, 30, 0, 0, loc), 0, false},
+		{"weekend", time.Date(2026, 1, 24, 10, 0, 0, 0, loc), 0, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := remainingInSession(hkex, tt.time)
+		if ok != tt.wantOK || got != tt.want {
+			t.Errorf("%s: expected %v (ok=%v), got %v (ok=%v)", tt.desc, tt.want, tt.wantOK, got, ok)
+		}
+	}
+}
+
+func TestChecker_TradingDuration(t *testing.T) {
+	checker := NewChecker()
+
+	loc, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	got, err := checker.TradingDuration(MarketChinaAShare, time.Date(2026, 1, 26, 0, 0, 0, 0, loc), time.Date(2026, 1, 27, 0, 0, 0, 0, loc))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if want := 4 * time.Hour; got != want {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+
+	remaining, ok, err := checker.RemainingInSession(MarketChinaAShare, time.Date(2026, 1, 26, 14, 0, 0, 0, loc))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !ok || remaining != time.Hour {
+		t.Errorf("Expected 1h remaining, got %v (ok=%v)", remaining, ok)
+	}
+
+	if _, err := checker.TradingDuration("UNKNOWN", time.Now(), time.Now()); err == nil {
+		t.Error("Expected error for unknown market type")
+	}
+
+	checker.AddMarket("CUSTOM", &statusOnlyMarket{})
+	if _, _, err := checker.RemainingInSession("CUSTOM", time.Now()); err == nil {
+		t.Error("Expected error for market without a session schedule")
+	}
+}
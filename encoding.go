@@ -0,0 +1,163 @@
+package marketchecker
+
+import (
+	"fmt"
+	"time"
+)
+
+// SchemaVersion is the version of the JSON encoding of StatusDetail and Session
+// It is incremented when a field is removed, renamed or changes meaning.
+// Adding an optional field does not change the version, so clients should
+// ignore fields they do not know.
+const SchemaVersion = 1
+
+// marketStatuses are the valid market statuses
+var marketStatuses = []MarketStatus{
+	StatusClosed,
+	StatusOpen,
+	StatusPremarket,
+	StatusPostmarket,
+	StatusOvernight,
+	StatusAfterHours,
+}
+
+// ParseMarketStatus returns the market status with the given name, e.g. "premarket"
+func ParseMarketStatus(s string) (MarketStatus, error) {
+	status := MarketStatus(s)
+	if !status.IsValid() {
+		return "", fmt.Errorf("unknown market status %q", s)
+	}
+	return status, nil
+}
+
+// IsValid checks if the status is one of the defined market statuses
+func (s MarketStatus) IsValid() bool {
+	for _, status := range marketStatuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalText encodes the status as its name
+// Besides the defined statuses only the empty status, returned alongside errors,
+// is accepted, so that structs holding a zero status can always be marshaled.
+func (s MarketStatus) MarshalText() ([]byte, error) {
+	if s != "" && !s.IsValid() {
+		return nil, fmt.Errorf("unknown market status %q", string(s))
+	}
+	return []byte(s), nil
+}
+
+// UnmarshalText decodes a status name
+// The empty text decodes to the empty status; other names must be defined statuses.
+func (s *MarketStatus) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*s = ""
+		return nil
+	}
+	status, err := ParseMarketStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*s = status
+	return nil
+}
+
+// MarshalText encodes the market type as its name
+// Custom market types and the empty market type are encoded as-is, so that
+// structs holding a zero market type can always be marshaled.
+func (m MarketType) MarshalText() ([]byte, error) {
+	return []byte(m), nil
+}
+
+// UnmarshalText decodes a market type from its name
+// Names are kept as-is, so custom market types and the empty market type
+// round-trip; MICs and aliases are not rewritten, use ParseMarketType or
+// Checker.ResolveMarket for that.
+func (m *MarketType) UnmarshalText(text []byte) error {
+	*m = MarketType(text)
+	return nil
+}
+
+// StatusDetail describes the status of a market at a point in time
+// Its JSON encoding is versioned by the SchemaVersion field, see the SchemaVersion constant.
+type StatusDetail struct {
+	SchemaVersion  int               `json:"schemaVersion"`
+	Market         MarketType        `json:"market"` // Canonical market type
+	Status         MarketStatus      `json:"status"`
+	LocalTime      time.Time         `json:"localTime"`         // RFC 3339 with the market's UTC offset
+	Timezone       string            `json:"timezone"`          // IANA name of the market's timezone
+	TradingDay     bool              `json:"tradingDay"`        // Whether the local date is a trading day
+	Holiday        bool              `json:"holiday"`           // Whether the local date is a weekday without trading
+	Session        *Session          `json:"session,omitempty"` // Session in progress, if any
+	NextTransition *StatusTransition `json:"nextTransition,omitempty"`
+}
+
+// StatusTransition is an upcoming status change of a market
+type StatusTransition struct {
+	Status MarketStatus `json:"status"` // Status after the change
+	At     time.Time    `json:"at"`     // RFC 3339 with the market's UTC offset
+}
+
+// StatusDetail returns the status of the specified market at the given time along with its context
+// The market may be given by name, MIC or alias; the detail reports the canonical market type.
+// Markets without a trading calendar report the time in the location of t and
+// no trading day information; markets without a session schedule report no
+// session or next transition. NextTransition is also nil if the next status change lies
+// beyond the market's holiday data or more than a year ahead.
+func (c *Checker) StatusDetail(marketType MarketType, t time.Time) (StatusDetail, error) {
+	marketType, err := c.ResolveMarket(string(marketType))
+	if err != nil {
+		return StatusDetail{}, err
+	}
+	market, err := c.marketAt(marketType, t)
+	if err != nil {
+		return StatusDetail{}, err
+	}
+
+	detail := StatusDetail{
+		SchemaVersion: SchemaVersion,
+		Market:        marketType,
+		Status:        market.GetStatus(t),
+		LocalTime:     t,
+		Timezone:      t.Location().String(),
+	}
+
+	if calendar, ok := market.(TradingCalendar); ok {
+		loc := calendar.Location()
+		day := startOfDay(t, loc)
+		detail.LocalTime = t.In(loc)
+		detail.Timezone = loc.String()
+		detail.TradingDay = calendar.IsTradingDay(day)
+		detail.Holiday = !detail.TradingDay && !IsWeekend(day)
+	}
+
+	if schedule, ok := market.(SessionSchedule); ok {
+		if session, ok := sessionAt(schedule, t); ok {
+			detail.Session = &session
+		}
+		if at, status, ok := nextStatusChange(schedule, t, detail.Status); ok {
+			detail.NextTransition = &StatusTransition{Status: status, At: at.In(schedule.Location())}
+		}
+	}
+	return detail, nil
+}
+
+// nextStatusChange returns the first session boundary after t at which the status differs from the given one
+// Boundaries between back-to-back sessions sharing a status are skipped.
+func nextStatusChange(schedule SessionSchedule, t time.Time, status MarketStatus) (time.Time, MarketStatus, bool) {
+	limit := t.AddDate(0, 0, maxTradingDaySearch)
+	for at := t; at.Before(limit); {
+		next, ok, err := nextTransition(schedule, at)
+		if err != nil || !ok {
+			break
+		}
+		if nextStatus := statusAt(schedule, next); nextStatus != status {
+			return next, nextStatus, true
+		}
+		at = next
+	}
+	return time.Time{}, "", false
+}
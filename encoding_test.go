@@ -0,0 +1,232 @@
+package marketchecker
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestMarketStatus_Text(t *testing.T) {
+	for _, status := range []MarketStatus{StatusClosed, StatusOpen, StatusPremarket, StatusPostmarket, StatusOvernight, StatusAfterHours, ""} {
+		text, err := status.MarshalText()
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", status, err)
+			continue
+		}
+		var decoded MarketStatus
+		if err := decoded.UnmarshalText(text); err != nil {
+			t.Errorf("%q: unexpected error: %v", status, err)
+		}
+		if decoded != status {
+			t.Errorf("Expected %q, got %q", status, decoded)
+		}
+	}
+
+	for _, invalid := range []string{"Open", "halted", " open"} {
+		if _, err := MarketStatus(invalid).MarshalText(); err == nil {
+			t.Errorf("Expected error marshaling %q", invalid)
+		}
+		var decoded MarketStatus
+		if err := decoded.UnmarshalText([]byte(invalid)); err == nil {
+			t.Errorf("Expected error unmarshaling %q", invalid)
+		}
+	}
+
+	// Structs holding a zero status, e.g. after an error, still marshal
+	data, err := json.Marshal(Session{})
+	if err != nil {
+		t.Fatalf("Unexpected error marshaling a zero session: %v", err)
+	}
+	var session Session
+	if err := json.Unmarshal(data, &session); err != nil || session.Status != "" {
+		t.Errorf("Expected the zero session to round-trip, got %+v, %v", session, err)
+	}
+}
+
+func TestMarketType_Text(t *testing.T) {
+	// Names are kept as-is, including MICs, custom and empty market types
+	for _, name := range []MarketType{MarketNASDAQ, "XHKG", "greater-china", ""} {
+		text, err := name.MarshalText()
+		if err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		var decoded MarketType
+		if err := decoded.UnmarshalText(text); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+		}
+		if decoded != name {
+			t.Errorf("Expected %s, got %s", name, decoded)
+		}
+	}
+}
+
+func TestChecker_StatusDetail(t *testing.T) {
+	checker := NewChecker()
+
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	tests := []struct {
+		desc           string
+		market         MarketType
+		time           time.Time
+		wantStatus     MarketStatus
+		wantTradingDay bool
+		wantHoliday    bool
+		wantNext       MarketStatus
+		wantNextAt     time.Time
+	}{
+		{
+			"regular session", MarketNASDAQ, time.Date(2026, 1, 20, 10, 0, 0, 0, ny),
+			StatusOpen, true, false, StatusPostmarket, time.Date(2026, 1, 20, 16, 0, 0, 0, ny),
+		},
+		{
+			"Martin Luther King Jr. Day", MarketNASDAQ, time.Date(2026, 1, 19, 12, 0, 0, 0, ny),
+			StatusClosed, false, true, StatusOvernight, time.Date(2026, 1, 19, 20, 0, 0, 0, ny),
+		},
+		{
+			"weekend", MarketNASDAQ, time.Date(2026, 1, 24, 12, 0, 0, 0, ny),
+			StatusClosed, false, false, StatusOvernight, time.Date(2026, 1, 25, 20, 0, 0, 0, ny),
+		},
+		{
+			// 12:30 HKT, during the lunch break
+			"lunch break", MarketHKEX, time.Date(2026, 1, 20, 4, 30, 0, 0, time.UTC),
+			StatusClosed, true, false, StatusOpen, time.Date(2026, 1, 20, 5, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		detail, err := checker.StatusDetail(tt.market, tt.time)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.desc, err)
+		}
+		if detail.SchemaVersion != SchemaVersion || detail.Market != tt.market {
+			t.Errorf("%s: unexpected header %d %s", tt.desc, detail.SchemaVersion, detail.Market)
+		}
+		if detail.Status != tt.wantStatus {
+			t.Errorf("%s: expected status %s, got %s", tt.desc, tt.wantStatus, detail.Status)
+		}
+		if detail.TradingDay != tt.wantTradingDay || detail.Holiday != tt.wantHoliday {
+			t.Errorf("%s: expected trading day %v and holiday %v, got %v and %v", tt.desc, tt.wantTradingDay, tt.wantHoliday, detail.TradingDay, detail.Holiday)
+		}
+		if detail.NextTransition == nil {
+			t.Errorf("%s: expected a next transition", tt.desc)
+			continue
+		}
+		if detail.NextTransition.Status != tt.wantNext || !detail.NextTransition.At.Equal(tt.wantNextAt) {
+			t.Errorf("%s: expected next transition to %s at %v, got %s at %v", tt.desc, tt.wantNext, tt.wantNextAt, detail.NextTransition.Status, detail.NextTransition.At)
+		}
+	}
+}
+
+func TestChecker_StatusDetailJSON(t *testing.T) {
+	checker := NewChecker()
+
+	detail, err := checker.StatusDetail("XNAS", time.Date(2026, 1, 20, 15, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	data, err := json.Marshal(detail)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := `{"schemaVersion":1,"market":"NASDAQ","status":"open","localTime":"2026-01-20T10:00:00-05:00",` +
+		`"timezone":"America/New_York","tradingDay":true,"holiday":false,` +
+		`"session":{"marketName":"NASDAQ","name":"regular","status":"open","start":"2026-01-20T09:30:00-05:00",` +
+		`"end":"2026-01-20T16:00:00-05:00","tradingDate":"2026-01-20T00:00:00-05:00"},` +
+		`"nextTransition":{"status":"postmarket","at":"2026-01-20T16:00:00-05:00"}}`
+	if string(data) != want {
+		t.Errorf("Expected\n%s\ngot\n%s", want, data)
+	}
+
+	var decoded StatusDetail
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if decoded.Market != MarketNASDAQ {
+		t.Errorf("Expected the MIC to be reported as %s, got %s", MarketNASDAQ, decoded.Market)
+	}
+	if decoded.Session == nil || decoded.Session.Name != "regular" || !decoded.Session.End.Equal(detail.Session.End) {
+		t.Errorf("Session round trip mismatch: %+v", decoded.Session)
+	}
+	if decoded.Status != StatusOpen || !decoded.LocalTime.Equal(detail.LocalTime) || decoded.NextTransition == nil {
+		t.Errorf("Round trip mismatch: %+v", decoded)
+	}
+
+	if err := json.Unmarshal([]byte(`{"market":"NASDAQ","status":"halted"}`), &decoded); err == nil {
+		t.Error("Expected error for an unknown status")
+	}
+}
+
+func TestChecker_StatusDetailGroup(t *testing.T) {
+	checker := NewChecker()
+	if _, err := checker.DefineGroup("greater-china-all", GroupIntersection, MarketHKEX, MarketChinaAShare); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// 10:00 HKT on Lunar New Year's Eve, a holiday in Shanghai only
+	detail, err := checker.StatusDetail("greater-china-all", time.Date(2026, 2, 16, 2, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if detail.Status != StatusClosed || detail.TradingDay || !detail.Holiday {
+		t.Errorf("Unexpected detail: %+v", detail)
+	}
+	if detail.Timezone != "Asia/Hong_Kong" {
+		t.Errorf("Expected the group's timezone, got %s", detail.Timezone)
+	}
+	if detail.Session != nil {
+		t.Errorf("Expected no session on a holiday, got %+v", detail.Session)
+	}
+	// Both markets reopen after the Lunar New Year holidays
+	if next := detail.NextTransition; next == nil || next.Status != StatusOpen || !next.At.Equal(time.Date(2026, 2, 23, 1, 30, 0, 0, time.UTC)) {
+		t.Errorf("Expected the group to open on 2026-02-23 09:30 HKT, got %+v", next)
+	}
+
+	// Custom market types round-trip through JSON
+	data, err := json.Marshal(detail)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var decoded StatusDetail
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if decoded.Market != "greater-china-all" {
+		t.Errorf("Expected greater-china-all, got %s", decoded.Market)
+	}
+}
+
+func TestChecker_StatusDetailEndOfCalendarCoverage(t *testing.T) {
+	checker := NewChecker()
+
+	hk, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	// After the last close covered by the HKEX holiday data the next open is unknown
+	detail, err := checker.StatusDetail(MarketHKEX, time.Date(2026, 12, 31, 13, 0, 0, 0, hk))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if detail.Status != StatusClosed || detail.NextTransition != nil {
+		t.Errorf("Expected closed without a next transition, got %+v", detail)
+	}
+}
+
+func TestChecker_StatusDetailErrors(t *testing.T) {
+	checker := NewChecker()
+
+	if _, err := checker.StatusDetail("NYSE", time.Now()); !errors.Is(err, ErrUnknownMarket) {
+		t.Errorf("Expected ErrUnknownMarket, got %v", err)
+	}
+	if _, err := checker.StatusDetail(MarketHKEX, time.Date(2030, 1, 2, 3, 0, 0, 0, time.UTC)); !errors.Is(err, ErrCalendarNotCovered) {
+		t.Errorf("Expected ErrCalendarNotCovered, got %v", err)
+	}
+}
@@ -0,0 +1,89 @@
+package marketchecker
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	// ErrUnknownMarket is returned when a market type is not registered in the checker
+	ErrUnknownMarket = errors.New("unknown market type")
+	// ErrCalendarNotCovered is returned when a date lies outside the period a market's holiday data covers
+	ErrCalendarNotCovered = errors.New("calendar not covered")
+	// ErrTimezoneUnavailable is returned when the timezone database entry of a market cannot be loaded
+	ErrTimezoneUnavailable = errors.New("timezone unavailable")
+	// ErrUnknownSymbol is returned when a symbol cannot be mapped to a market
+	ErrUnknownSymbol = errors.New("unknown symbol")
+)
+
+// UnknownMarketError reports a market type that is not registered in the checker
+// It matches ErrUnknownMarket with errors.Is.
+type UnknownMarketError struct {
+	Market MarketType
+}
+
+// Error returns the error message
+func (e *UnknownMarketError) Error() string {
+	return fmt.Sprintf("unknown market type: %s", e.Market)
+}
+
+// Is reports whether target is ErrUnknownMarket
+func (e *UnknownMarketError) Is(target error) bool {
+	return target == ErrUnknownMarket
+}
+
+// CalendarNotCoveredError reports a date outside the period a market's holiday data covers
+// It matches ErrCalendarNotCovered with errors.Is.
+type CalendarNotCoveredError struct {
+	Market string    // Market name
+	Date   time.Time // Date that is not covered
+}
+
+// Error returns the error message
+func (e *CalendarNotCoveredError) Error() string {
+	return fmt.Sprintf("calendar of %s does not cover %s", e.Market, e.Date.Format("2006-01-02"))
+}
+
+// Is reports whether target is ErrCalendarNotCovered
+func (e *CalendarNotCoveredError) Is(target error) bool {
+	return target == ErrCalendarNotCovered
+}
+
+// TimezoneError reports a timezone that could not be loaded from the timezone database
+// It matches ErrTimezoneUnavailable with errors.Is and unwraps to the underlying load error.
+type TimezoneError struct {
+	Name string // IANA timezone name, e.g. "Asia/Hong_Kong"
+	Err  error
+}
+
+// Error returns the error message
+func (e *TimezoneError) Error() string {
+	return fmt.Sprintf("timezone %s unavailable: %v", e.Name, e.Err)
+}
+
+// Is reports whether target is ErrTimezoneUnavailable
+func (e *TimezoneError) Is(target error) bool {
+	return target == ErrTimezoneUnavailable
+}
+
+// Unwrap returns the underlying load error
+func (e *TimezoneError) Unwrap() error {
+	return e.Err
+}
+
+// UnknownSymbolError reports a symbol that no symbol rule maps to a market
+// It matches ErrUnknownSymbol with errors.Is.
+type UnknownSymbolError struct {
+	Symbol string
+}
+
+// Error returns the error message
+func (e *UnknownSymbolError) Error() string {
+	return fmt.Sprintf("unknown symbol: %s", e.Symbol)
+}
+
+// Is reports whether target is ErrUnknownSymbol
+func (e *UnknownSymbolError) Is(target error) bool {
+	return target == ErrUnknownSymbol
+}
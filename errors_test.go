@@ -0,0 +1,109 @@
+package marketchecker
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestUnknownMarketError(t *testing.T) {
+	checker := NewChecker()
+	now := time.Date(2026, 1, 26, 12, 0, 0, 0, time.UTC)
+
+	_, isOpenErr := checker.IsOpen("UNKNOWN", now)
+	status, getStatusErr := checker.GetStatus("UNKNOWN", now)
+	_, getMarketErr := checker.GetMarket("UNKNOWN")
+	_, tradingDayErr := checker.IsTradingDay("UNKNOWN", now)
+	_, sessionsErr := checker.Sessions("UNKNOWN", now, now.Add(time.Hour))
+	_, settlementErr := checker.SettlementDate("UNKNOWN", now)
+	_, overlapErr := checker.OverlapWindows([]MarketType{MarketHKEX, "UNKNOWN"}, now, now.Add(time.Hour), nil)
+	_, groupErr := checker.DefineGroup("group", GroupUnion, "UNKNOWN")
+	removeErr := checker.RemoveMarket("UNKNOWN")
+
+	for _, err := range []error{isOpenErr, getStatusErr, getMarketErr, tradingDayErr, sessionsErr, settlementErr, overlapErr, groupErr, removeErr} {
+		if !errors.Is(err, ErrUnknownMarket) {
+			t.Errorf("Expected ErrUnknownMarket, got %v", err)
+		}
+		var unknown *UnknownMarketError
+		if !errors.As(err, &unknown) || unknown.Market != "UNKNOWN" {
+			t.Errorf("Expected UnknownMarketError for UNKNOWN, got %v", err)
+		}
+	}
+
+	if status != "" {
+		t.Errorf("Expected empty status alongside an error, got %s", status)
+	}
+}
+
+func TestCalendarNotCoveredError(t *testing.T) {
+	checker := NewChecker()
+
+	hk, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	// Holiday lists of HKEX and the mainland exchanges only cover 2025 and 2026
+	uncovered := time.Date(2027, 1, 4, 10, 0, 0, 0, hk)
+
+	_, isOpenErr := checker.IsOpen(MarketHKEX, uncovered)
+	status, getStatusErr := checker.GetStatus(MarketChinaAShare, uncovered)
+	_, tradingDayErr := checker.IsTradingDay(MarketStockConnectNorthbound, uncovered)
+	_, sessionsErr := checker.Sessions(MarketHKEX, uncovered, uncovered.AddDate(0, 0, 1))
+	_, durationErr := checker.TradingDuration(MarketSHFEPreciousMetals, uncovered, uncovered.Add(time.Hour))
+
+	// Counting past the end of the holiday data fails rather than guessing
+	_, nextErr := checker.NextTradingDay(MarketHKEX, time.Date(2026, 12, 31, 0, 0, 0, 0, hk))
+	_, settlementErr := checker.SettlementDate(MarketStockConnectSouthbound, time.Date(2026, 12, 30, 0, 0, 0, 0, hk))
+
+	for _, err := range []error{isOpenErr, getStatusErr, tradingDayErr, sessionsErr, durationErr, nextErr, settlementErr} {
+		if !errors.Is(err, ErrCalendarNotCovered) {
+			t.Errorf("Expected ErrCalendarNotCovered, got %v", err)
+		}
+		var notCovered *CalendarNotCoveredError
+		if !errors.As(err, &notCovered) || notCovered.Date.Year() != 2027 {
+			t.Errorf("Expected CalendarNotCoveredError for 2027, got %v", err)
+		}
+	}
+	if status != "" {
+		t.Errorf("Expected empty status alongside an error, got %s", status)
+	}
+
+	// Rule-based calendars are not limited
+	if _, err := checker.IsOpen(MarketNASDAQ, uncovered); err != nil {
+		t.Errorf("Unexpected error for NASDAQ: %v", err)
+	}
+	if _, err := checker.IsOpen(MarketHKEX, time.Date(2026, 12, 31, 10, 0, 0, 0, hk)); err != nil {
+		t.Errorf("Unexpected error within the holiday data: %v", err)
+	}
+}
+
+func TestUnsupportedMarketErrors(t *testing.T) {
+	checker := NewChecker()
+	checker.AddMarket("CUSTOM", &statusOnlyMarket{})
+	now := time.Date(2026, 1, 26, 12, 0, 0, 0, time.UTC)
+
+	_, tradingDayErr := checker.IsTradingDay("CUSTOM", now)
+	_, sessionsErr := checker.Sessions("CUSTOM", now, now.Add(time.Hour))
+	_, settlementErr := checker.SettlementDate("CUSTOM", now)
+
+	for _, err := range []error{tradingDayErr, sessionsErr, settlementErr} {
+		if !errors.Is(err, errors.ErrUnsupported) {
+			t.Errorf("Expected errors.ErrUnsupported, got %v", err)
+		}
+	}
+}
+
+func TestTimezoneError(t *testing.T) {
+	_, err := loadLocation("Not/A_Zone")
+	if !errors.Is(err, ErrTimezoneUnavailable) {
+		t.Errorf("Expected ErrTimezoneUnavailable, got %v", err)
+	}
+	var tzErr *TimezoneError
+	if !errors.As(err, &tzErr) || tzErr.Name != "Not/A_Zone" {
+		t.Errorf("Expected TimezoneError for Not/A_Zone, got %v", err)
+	}
+	if errors.Unwrap(err) == nil {
+		t.Error("Expected TimezoneError to wrap the load error")
+	}
+}
@@ -0,0 +1,250 @@
+package marketchecker
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// GroupMode determines how the members of a market group are combined
+type GroupMode string
+
+const (
+	// GroupUnion treats the group as open when any member is open
+	GroupUnion GroupMode = "union"
+	// GroupIntersection treats the group as open only when all members are open
+	GroupIntersection GroupMode = "intersection"
+)
+
+// MarketGroup is a composite market made of several member markets
+// A union group trades on days any member trades; an intersection group only on days all members trade.
+// Its sessions combine the member sessions, see SessionsOn.
+type MarketGroup struct {
+	name      string
+	mode      GroupMode
+	members   []MarketType
+	markets   []Market
+	calendars []TradingCalendar
+}
+
+// DefineGroup creates a market group from registered markets and registers it under the group name
+// The group can then be queried like any other market, e.g. c.IsOpen(MarketType("greater-china"), t).
+// Returns an error if a market is already registered under the name.
+func (c *Checker) DefineGroup(name string, mode GroupMode, members ...MarketType) (*MarketGroup, error) {
+	if mode != GroupUnion && mode != GroupIntersection {
+		return nil, fmt.Errorf("unknown group mode: %s", mode)
+	}
+	if len(members) == 0 {
+		return nil, fmt.Errorf("market group %s has no members", name)
+	}
+
+	group := &MarketGroup{name: name, mode: mode}
+	for _, marketType := range members {
+		if marketType == MarketType(name) {
+			return nil, fmt.Errorf("market group %s cannot contain itself", name)
+		}
+		calendar, err := c.tradingCalendar(marketType)
+		if err != nil {
+			return nil, err
+		}
+		group.members = append(group.members, marketType)
+		group.markets = append(group.markets, calendar.(Market))
+		group.calendars = append(group.calendars, calendar)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, exists := c.markets[MarketType(name)]; exists {
+		return nil, fmt.Errorf("market %s is already registered", name)
+	}
+	c.markets[MarketType(name)] = group
+	return group, nil
+}
+
+// Name returns the group name
+func (g *MarketGroup) Name() string {
+	return g.name
+}
+
+// Mode returns how the members of the group are combined
+func (g *MarketGroup) Mode() GroupMode {
+	return g.mode
+}
+
+// Members returns the market types of the group members
+func (g *MarketGroup) Members() []MarketType {
+	return append([]MarketType(nil), g.members...)
+}
+
+// Location returns the timezone of the first member, in which the group's dates are expressed
+func (g *MarketGroup) Location() *time.Location {
+	return g.calendars[0].Location()
+}
+
+// IsOpen checks if any (union) or all (intersection) members are open at the given time
+func (g *MarketGroup) IsOpen(t time.Time) bool {
+	for _, market := range g.markets {
+		open := market.IsOpen(t)
+		if g.mode == GroupUnion && open {
+			return true
+		}
+		if g.mode == GroupIntersection && !open {
+			return false
+		}
+	}
+	return g.mode == GroupIntersection
+}
+
+// GetStatus returns the combined status of the group at the given time
+// A union group is open if any member is open, otherwise it takes the first non-closed member status.
+// An intersection group takes the members' status only if they all share it and is closed otherwise.
+func (g *MarketGroup) GetStatus(t time.Time) MarketStatus {
+	statuses := make([]MarketStatus, len(g.markets))
+	for i, market := range g.markets {
+		statuses[i] = market.GetStatus(t)
+	}
+	return g.combine(statuses)
+}
+
+// combine returns the group status for the given member statuses, see GetStatus
+func (g *MarketGroup) combine(statuses []MarketStatus) MarketStatus {
+	if g.mode == GroupIntersection {
+		for _, status := range statuses[1:] {
+			if status != statuses[0] {
+				return StatusClosed
+			}
+		}
+		return statuses[0]
+	}
+
+	combined := StatusClosed
+	for _, status := range statuses {
+		if status == StatusOpen {
+			return StatusOpen
+		}
+		if combined == StatusClosed {
+			combined = status
+		}
+	}
+	return combined
+}
+
+// SessionsOn returns the combined sessions of the members for the given trading date
+// The date is evaluated in the group's timezone and each member's sessions of
+// the same calendar date are combined like GetStatus: a union group has a
+// session wherever any member is in one, an intersection group only while all
+// members share the same status. Each session is named after the first member
+// session it starts with. Members without a session schedule have no sessions,
+// so an intersection group containing one has no sessions either.
+func (g *MarketGroup) SessionsOn(date time.Time) []Session {
+	day := startOfDay(date, g.Location())
+	if !g.IsTradingDay(day) {
+		return nil
+	}
+
+	members := make([][]Session, len(g.markets))
+	var boundaries []time.Time
+	for i, market := range g.markets {
+		schedule, ok := market.(SessionSchedule)
+		if !ok {
+			continue
+		}
+		members[i] = schedule.SessionsOn(time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, schedule.Location()))
+		for _, session := range members[i] {
+			boundaries = append(boundaries, session.Start, session.End)
+		}
+	}
+	sort.Slice(boundaries, func(i, j int) bool {
+		return boundaries[i].Before(boundaries[j])
+	})
+
+	var sessions []Session
+	statuses := make([]MarketStatus, len(g.markets))
+	names := make([]string, len(g.markets))
+	for i := 0; i+1 < len(boundaries); i++ {
+		start, end := boundaries[i], boundaries[i+1]
+		if !start.Before(end) {
+			continue
+		}
+
+		for j, memberSessions := range members {
+			statuses[j], names[j] = StatusClosed, ""
+			for _, session := range memberSessions {
+				if session.Contains(start) {
+					statuses[j], names[j] = session.Status, session.Name
+					break
+				}
+			}
+		}
+		status := g.combine(statuses)
+		if status == StatusClosed {
+			continue
+		}
+		name := ""
+		for j := range statuses {
+			if statuses[j] == status {
+				name = names[j]
+				break
+			}
+		}
+
+		if last := len(sessions) - 1; last >= 0 && sessions[last].End.Equal(start) && sessions[last].Status == status {
+			sessions[last].End = end
+			continue
+		}
+		sessions = append(sessions, Session{
+			Market:      g.Name(),
+			Name:        name,
+			Status:      status,
+			Start:       start,
+			End:         end,
+			TradingDate: day,
+		})
+	}
+	return sessions
+}
+
+// IsTradingDay checks if the given date is a trading day of any (union) or all (intersection) members
+// The date is evaluated in the group's timezone and then checked against each member's calendar date.
+func (g *MarketGroup) IsTradingDay(t time.Time) bool {
+	day := startOfDay(t, g.Location())
+	for _, calendar := range g.calendars {
+		local := time.Date(day.Year(), day.Month(), day.Day(), 12, 0, 0, 0, calendar.Location())
+		trading := calendar.IsTradingDay(local)
+		if g.mode == GroupUnion && trading {
+			return true
+		}
+		if g.mode == GroupIntersection && !trading {
+			return false
+		}
+	}
+	return g.mode == GroupIntersection
+}
+
+// Covers checks if the holiday data of every member covers the given date
+func (g *MarketGroup) Covers(t time.Time) bool {
+	for _, calendar := range g.calendars {
+		if checkCoverage(calendar, t) != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// IsHoliday checks if the given weekday is not a trading day of the group
+func (g *MarketGroup) IsHoliday(t time.Time) bool {
+	day := startOfDay(t, g.Location())
+	return !IsWeekend(day) && !g.IsTradingDay(day)
+}
+
+// Holidays returns the combined holiday list of the group within [from, to)
+// A union group lists the weekdays on which no member trades; an intersection group the weekdays on which any member is closed.
+func (g *MarketGroup) Holidays(from, to time.Time) []time.Time {
+	var holidays []time.Time
+	for day := startOfDay(from, g.Location()); day.Before(to); day = day.AddDate(0, 0, 1) {
+		if g.IsHoliday(day) {
+			holidays = append(holidays, day)
+		}
+	}
+	return holidays
+}
@@ -0,0 +1,243 @@
+package marketchecker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestChecker_DefineGroup(t *testing.T) {
+	checker := NewChecker()
+
+	hk, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	if _, err := checker.DefineGroup("greater-china-any", GroupUnion, MarketHKEX, MarketChinaAShare); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := checker.DefineGroup("greater-china-all", GroupIntersection, MarketHKEX, MarketChinaAShare); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		desc    string
+		time    time.Time
+		wantAny MarketStatus
+		wantAll MarketStatus
+	}{
+		{"both open", time.Date(2026, 1, 26, 10, 0, 0, 0, hk), StatusOpen, StatusOpen},
+		{"only HKEX open", time.Date(2026, 1, 26, 15, 30, 0, 0, hk), StatusOpen, StatusClosed},
+		{"both at lunch", time.Date(2026, 1, 26, 12, 15, 0, 0, hk), StatusClosed, StatusClosed},
+		{"weekend", time.Date(2026, 1, 24, 10, 0, 0, 0, hk), StatusClosed, StatusClosed},
+	}
+
+	for _, tt := range tests {
+		status, err := checker.GetStatus("greater-china-any", tt.time)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.desc, err)
+		}
+		if status != tt.wantAny {
+			t.Errorf("%s: expected union status %s, got %s", tt.desc, tt.wantAny, status)
+		}
+		status, err = checker.GetStatus("greater-china-all", tt.time)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.desc, err)
+		}
+		if status != tt.wantAll {
+			t.Errorf("%s: expected intersection status %s, got %s", tt.desc, tt.wantAll, status)
+		}
+	}
+}
+
+func TestMarketGroup_GetStatusUnionExtendedHours(t *testing.T) {
+	checker := NewChecker()
+	group, err := checker.DefineGroup("us", GroupUnion, MarketNASDAQ, MarketTSX)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	// NASDAQ premarket while TSX is closed
+	if status := group.GetStatus(time.Date(2026, 1, 27, 8, 0, 0, 0, loc)); status != StatusPremarket {
+		t.Errorf("Expected premarket, got %s", status)
+	}
+}
+
+func TestMarketGroup_Holidays(t *testing.T) {
+	checker := NewChecker()
+	union, err := checker.DefineGroup("greater-china-any", GroupUnion, MarketHKEX, MarketChinaAShare)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	intersection, err := checker.DefineGroup("greater-china-all", GroupIntersection, MarketHKEX, MarketChinaAShare)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	hk, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	// Lunar New Year week: HKEX closes Feb 17-19, China A-Share Feb 16-20
+	from := time.Date(2026, 2, 14, 0, 0, 0, 0, hk)
+	to := time.Date(2026, 2, 24, 0, 0, 0, 0, hk)
+
+	got := union.Holidays(from, to)
+	if len(got) != 3 || got[0].Day() != 17 || got[2].Day() != 19 {
+		t.Errorf("Expected union holidays Feb 17-19, got %v", got)
+	}
+
+	got = intersection.Holidays(from, to)
+	if len(got) != 5 || got[0].Day() != 16 || got[4].Day() != 20 {
+		t.Errorf("Expected intersection holidays Feb 16-20, got %v", got)
+	}
+
+	// Groups work with the trading-day arithmetic of the checker
+	next, err := checker.NextTradingDay("greater-china-all", time.Date(2026, 2, 13, 0, 0, 0, 0, hk))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if want := time.Date(2026, 2, 23, 0, 0, 0, 0, hk); !next.Equal(want) {
+		t.Errorf("Expected next trading day %v, got %v", want, next)
+	}
+}
+
+func TestChecker_DefineGroupErrors(t *testing.T) {
+	checker := NewChecker()
+
+	if _, err := checker.DefineGroup("empty", GroupUnion); err == nil {
+		t.Error("Expected error for group without members")
+	}
+	if _, err := checker.DefineGroup("bad-mode", "xor", MarketHKEX); err == nil {
+		t.Error("Expected error for unknown group mode")
+	}
+	if _, err := checker.DefineGroup("unknown", GroupUnion, MarketHKEX, "UNKNOWN"); err == nil {
+		t.Error("Expected error for unknown member")
+	}
+	if _, err := checker.DefineGroup("self", GroupUnion, MarketHKEX, "self"); err == nil {
+		t.Error("Expected error for group containing itself")
+	}
+	if _, err := checker.DefineGroup(string(MarketNASDAQ), GroupUnion, MarketHKEX); err == nil {
+		t.Error("Expected error for group named after a registered market")
+	}
+	if market, err := checker.GetMarket(MarketNASDAQ); err != nil || market.Name() != "NASDAQ" {
+		t.Errorf("Expected NASDAQ to stay registered, got %v, %v", market, err)
+	}
+	if _, err := checker.DefineGroup("greater-china", GroupUnion, MarketHKEX, MarketChinaAShare); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := checker.DefineGroup("greater-china", GroupIntersection, MarketHKEX, MarketChinaAShare); err == nil {
+		t.Error("Expected error for a group name defined twice")
+	}
+
+	checker.AddMarket("CUSTOM", &statusOnlyMarket{})
+	if _, err := checker.DefineGroup("custom", GroupUnion, "CUSTOM"); err == nil {
+		t.Error("Expected error for member without a trading calendar")
+	}
+	if _, err := checker.GetMarket("custom"); err == nil {
+		t.Error("Expected failed group not to be registered")
+	}
+}
+
+func TestMarketGroup_SessionsOn(t *testing.T) {
+	checker := NewChecker()
+	union, err := checker.DefineGroup("greater-china-any", GroupUnion, MarketHKEX, MarketChinaAShare)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	intersection, err := checker.DefineGroup("greater-china-all", GroupIntersection, MarketHKEX, MarketChinaAShare)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	hk, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+	at := func(hour, minute int) time.Time {
+		return time.Date(2026, 1, 26, hour, minute, 0, 0, hk)
+	}
+
+	tests := []struct {
+		desc  string
+		group *MarketGroup
+		want  [][2]time.Time
+	}{
+		// HKEX 9:30-12:00 and 13:00-16:00, China A-Share 9:30-11:30 and 13:00-15:00
+		{"union", union, [][2]time.Time{{at(9, 30), at(12, 0)}, {at(13, 0), at(16, 0)}}},
+		{"intersection", intersection, [][2]time.Time{{at(9, 30), at(11, 30)}, {at(13, 0), at(15, 0)}}},
+	}
+
+	for _, tt := range tests {
+		sessions := tt.group.SessionsOn(at(0, 0))
+		if len(sessions) != len(tt.want) {
+			t.Fatalf("%s: expected %d sessions, got %+v", tt.desc, len(tt.want), sessions)
+		}
+		for i, session := range sessions {
+			if !session.Start.Equal(tt.want[i][0]) || !session.End.Equal(tt.want[i][1]) {
+				t.Errorf("%s: expected session %v - %v, got %v - %v", tt.desc, tt.want[i][0], tt.want[i][1], session.Start, session.End)
+			}
+			if session.Market != tt.group.Name() || session.Status != StatusOpen || !session.TradingDate.Equal(at(0, 0)) {
+				t.Errorf("%s: unexpected session %+v", tt.desc, session)
+			}
+		}
+	}
+
+	// Lunar New Year's Eve is a half day in Hong Kong and a holiday in Shanghai
+	if sessions := union.SessionsOn(time.Date(2026, 2, 16, 0, 0, 0, 0, hk)); len(sessions) != 1 || !sessions[0].End.Equal(time.Date(2026, 2, 16, 12, 0, 0, 0, hk)) {
+		t.Errorf("Expected the HKEX half day session only, got %+v", sessions)
+	}
+	if sessions := intersection.SessionsOn(time.Date(2026, 2, 16, 0, 0, 0, 0, hk)); sessions != nil {
+		t.Errorf("Expected no intersection sessions, got %+v", sessions)
+	}
+
+	// The session-based checker APIs work with groups
+	duration, err := checker.TradingDuration("greater-china-all", at(0, 0), at(23, 59))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if want := 4 * time.Hour; duration != want {
+		t.Errorf("Expected %v of intersection trading, got %v", want, duration)
+	}
+}
+
+func TestMarketGroup_SessionsOnExtendedHours(t *testing.T) {
+	checker := NewChecker()
+	group, err := checker.DefineGroup("us", GroupUnion, MarketNASDAQ, MarketTSX)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	// The session statuses agree with GetStatus
+	for at := time.Date(2026, 1, 26, 20, 0, 0, 0, loc); at.Before(time.Date(2026, 1, 28, 4, 0, 0, 0, loc)); at = at.Add(15 * time.Minute) {
+		status, err := checker.GetStatus("us", at)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if want := group.GetStatus(at); status != want {
+			t.Fatalf("Expected status %s at %v, got %s", want, at, status)
+		}
+		detail, err := checker.StatusDetail("us", at)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		sessionStatus := StatusClosed
+		if detail.Session != nil {
+			sessionStatus = detail.Session.Status
+		}
+		if sessionStatus != status {
+			t.Errorf("Expected session status %s at %v, got %s", status, at, sessionStatus)
+		}
+	}
+}
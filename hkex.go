@@ -7,6 +7,7 @@ import (
 // HKEX represents the Hong Kong Stock Exchange
 type HKEX struct{
 	holidayProvider HolidayProvider
+	halfDayProvider HolidayProvider
 }
 
 var (
@@ -15,18 +16,14 @@ var (
 )
 
 func init() {
-	var err error
-	hkexLocation, err = time.LoadLocation("Asia/Hong_Kong")
-	if err != nil {
-		// Fallback to UTC+8 if location loading fails
-		hkexLocation = time.FixedZone("HKT", 8*3600)
-	}
+	hkexLocation = mustLoadLocation("Asia/Hong_Kong")
 }
 
 // NewHKEX creates a new HKEX market instance
 func NewHKEX() *HKEX {
 	return &HKEX{
 		holidayProvider: NewStaticHolidayProvider(hkexHolidays),
+		halfDayProvider: NewStaticHolidayProvider(hkexHalfDays),
 	}
 }
 
@@ -35,6 +32,11 @@ func (h *HKEX) Name() string {
 	return "HKEX"
 }
 
+// Location returns the market timezone
+func (h *HKEX) Location() *time.Location {
+	return hkexLocation
+}
+
 // IsOpen checks if HKEX is open for trading at the given time
 func (h *HKEX) IsOpen(t time.Time) bool {
 	status := h.GetStatus(t)
@@ -43,36 +45,55 @@ func (h *HKEX) IsOpen(t time.Time) bool {
 
 // GetStatus returns the current market status at the given time
 func (h *HKEX) GetStatus(t time.Time) MarketStatus {
-	// Convert to Hong Kong Time
-	localTime := t.In(hkexLocation)
-
-	// Check if it's a holiday first
-	if h.holidayProvider != nil && h.holidayProvider.IsHoliday(localTime) {
-		return StatusClosed
-	}
+	return statusAt(h, t)
+}
 
-	// Check if it's weekend
-	if IsWeekend(localTime) {
-		return StatusClosed
+// SessionsOn returns the HKEX trading sessions belonging to the given trading date
+func (h *HKEX) SessionsOn(date time.Time) []Session {
+	day := startOfDay(date, hkexLocation)
+	if !h.IsTradingDay(day) {
+		return nil
 	}
 
 	// HKEX trading hours (Hong Kong Time):
 	// Morning session: 9:30 AM - 12:00 PM
 	// Afternoon session: 1:00 PM - 4:00 PM
+	// Half trading days have the morning session only
 
 	morningSession := TimeRange{
-		Start: 9*time.Hour + 30*time.Minute,
-		End:   12 * time.Hour,
+		Start: TimeOfDay{Hour: 9, Minute: 30},
+		End:   TimeOfDay{Hour: 12},
 	}
 
 	afternoonSession := TimeRange{
-		Start: 13 * time.Hour,
-		End:   16 * time.Hour,
+		Start: TimeOfDay{Hour: 13},
+		End:   TimeOfDay{Hour: 16},
 	}
 
-	if morningSession.IsWithin(localTime) || afternoonSession.IsWithin(localTime) {
-		return StatusOpen
+	sessions := []Session{
+		newSession(h.Name(), "morning", StatusOpen, day, morningSession, day),
+	}
+	if !h.isHalfDay(day) {
+		sessions = append(sessions, newSession(h.Name(), "afternoon", StatusOpen, day, afternoonSession, day))
 	}
+	return sessions
+}
+
+// IsTradingDay checks if the given date is an HKEX trading day
+func (h *HKEX) IsTradingDay(t time.Time) bool {
+	localTime := t.In(hkexLocation)
+	if h.holidayProvider != nil && h.holidayProvider.IsHoliday(localTime) {
+		return false
+	}
+	return !IsWeekend(localTime)
+}
+
+// Covers checks if the holiday data covers the given date
+func (h *HKEX) Covers(t time.Time) bool {
+	return holidaysCover(h.holidayProvider, t.In(hkexLocation))
+}
 
-	return StatusClosed
+// isHalfDay checks if the given date is an HKEX half trading day
+func (h *HKEX) isHalfDay(t time.Time) bool {
+	return h.halfDayProvider != nil && h.halfDayProvider.IsHoliday(t.In(hkexLocation))
 }
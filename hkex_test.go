@@ -122,3 +122,23 @@ func TestHKEX_HolidayClosed(t *testing.T) {
 		t.Errorf("Expected status %s on Christmas, got %s", StatusClosed, status)
 	}
 }
+
+func TestHKEX_HalfDay(t *testing.T) {
+	hkex := NewHKEX()
+
+	loc, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	// Christmas Eve 2026 is a half trading day - morning session only
+	morning := time.Date(2026, 12, 24, 10, 0, 0, 0, loc)
+	if !hkex.IsOpen(morning) {
+		t.Errorf("HKEX should be open on Christmas Eve morning at %v", morning)
+	}
+
+	afternoon := time.Date(2026, 12, 24, 14, 0, 0, 0, loc)
+	if hkex.IsOpen(afternoon) {
+		t.Errorf("HKEX should be closed on Christmas Eve afternoon at %v", afternoon)
+	}
+}
@@ -0,0 +1,108 @@
+package marketchecker
+
+import (
+	"time"
+)
+
+// HKEXDerivatives represents the HKEX futures and options market
+//
+// Besides the day session, index futures and options trade in an after-hours
+// (T+1) session from 5:15 PM to 3:00 AM the next morning. Trades executed in
+// the after-hours session belong to the next trading day.
+type HKEXDerivatives struct {
+	holidayProvider HolidayProvider
+	halfDayProvider HolidayProvider
+}
+
+// NewHKEXDerivatives creates a new HKEX derivatives market instance
+func NewHKEXDerivatives() *HKEXDerivatives {
+	return &HKEXDerivatives{
+		holidayProvider: NewStaticHolidayProvider(hkexHolidays),
+		halfDayProvider: NewStaticHolidayProvider(hkexHalfDays),
+	}
+}
+
+// Name returns the market name
+func (d *HKEXDerivatives) Name() string {
+	return "HKEX Derivatives"
+}
+
+// Location returns the market timezone
+func (d *HKEXDerivatives) Location() *time.Location {
+	return hkexLocation
+}
+
+// IsOpen checks if the HKEX derivatives day session is open at the given time
+func (d *HKEXDerivatives) IsOpen(t time.Time) bool {
+	status := d.GetStatus(t)
+	return status == StatusOpen
+}
+
+// GetStatus returns the current market status at the given time
+func (d *HKEXDerivatives) GetStatus(t time.Time) MarketStatus {
+	return statusAt(d, t)
+}
+
+// SessionsOn returns the HKEX derivatives trading sessions belonging to the given trading date
+// The after-hours session held on the evening of the previous trading day belongs to the trading date.
+func (d *HKEXDerivatives) SessionsOn(date time.Time) []Session {
+	day := startOfDay(date, hkexLocation)
+	if !d.IsTradingDay(day) {
+		return nil
+	}
+
+	// HKEX index derivatives trading hours (Hong Kong Time):
+	// After-hours session: 5:15 PM - 3:00 AM, held on the previous trading day
+	// Morning session: 9:15 AM - 12:00 PM
+	// Afternoon session: 1:00 PM - 4:30 PM
+	// Half trading days: 9:15 AM - 12:30 PM, no after-hours session
+
+	afterHours := TimeRange{
+		Start: TimeOfDay{Hour: 17, Minute: 15},
+		End:   TimeOfDay{Hour: 3},
+	}
+
+	morningSession := TimeRange{
+		Start: TimeOfDay{Hour: 9, Minute: 15},
+		End:   TimeOfDay{Hour: 12},
+	}
+
+	afternoonSession := TimeRange{
+		Start: TimeOfDay{Hour: 13},
+		End:   TimeOfDay{Hour: 16, Minute: 30},
+	}
+
+	var sessions []Session
+	if previous, err := stepTradingDay(d, day, -1); err == nil && !d.isHalfDay(previous) {
+		sessions = append(sessions, newSession(d.Name(), "after-hours", StatusAfterHours, previous, afterHours, day))
+	}
+
+	if d.isHalfDay(day) {
+		morningSession.End = TimeOfDay{Hour: 12, Minute: 30}
+		return append(sessions, newSession(d.Name(), "morning", StatusOpen, day, morningSession, day))
+	}
+
+	return append(sessions,
+		newSession(d.Name(), "morning", StatusOpen, day, morningSession, day),
+		newSession(d.Name(), "afternoon", StatusOpen, day, afternoonSession, day),
+	)
+}
+
+// IsTradingDay checks if the given date is an HKEX trading day
+func (d *HKEXDerivatives) IsTradingDay(t time.Time) bool {
+	localTime := t.In(hkexLocation)
+	if d.holidayProvider != nil && d.holidayProvider.IsHoliday(localTime) {
+		return false
+	}
+	return !IsWeekend(localTime)
+}
+
+// Covers checks if the holiday data covers the given date
+func (d *HKEXDerivatives) Covers(t time.Time) bool {
+	return holidaysCover(d.holidayProvider, t.In(hkexLocation))
+}
+
+// isHalfDay checks if the given date is an HKEX half trading day
+func (d *HKEXDerivatives) isHalfDay(t time.Time) bool {
+	return d.halfDayProvider != nil && d.halfDayProvider.IsHoliday(t.In(hkexLocation))
+}
@@ -0,0 +1,128 @@
+package marketchecker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHKEXDerivatives_DaySession(t *testing.T) {
+	derivatives := NewHKEXDerivatives()
+
+	loc, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	tests := []struct {
+		desc string
+		time time.Time
+		want MarketStatus
+	}{
+		{"Before the open", time.Date(2026, 1, 19, 9, 0, 0, 0, loc), StatusClosed},
+		{"Morning session", time.Date(2026, 1, 19, 9, 20, 0, 0, loc), StatusOpen},
+		{"Lunch break", time.Date(2026, 1, 19, 12, 30, 0, 0, loc), StatusClosed},
+		{"Afternoon session", time.Date(2026, 1, 19, 16, 15, 0, 0, loc), StatusOpen},
+		{"Between day and after-hours sessions", time.Date(2026, 1, 19, 16, 45, 0, 0, loc), StatusClosed},
+		{"After-hours session", time.Date(2026, 1, 19, 17, 30, 0, 0, loc), StatusAfterHours},
+		{"After-hours session past midnight", time.Date(2026, 1, 20, 2, 30, 0, 0, loc), StatusAfterHours},
+		{"After the after-hours session", time.Date(2026, 1, 20, 3, 30, 0, 0, loc), StatusClosed},
+	}
+
+	for _, tt := range tests {
+		if status := derivatives.GetStatus(tt.time); status != tt.want {
+			t.Errorf("%s: expected status %s at %v, got %s", tt.desc, tt.want, tt.time, status)
+		}
+	}
+}
+
+func TestHKEXDerivatives_WeekendAndHolidays(t *testing.T) {
+	derivatives := NewHKEXDerivatives()
+
+	loc, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	tests := []struct {
+		desc string
+		time time.Time
+		want MarketStatus
+	}{
+		{"Friday night session", time.Date(2026, 1, 23, 22, 0, 0, 0, loc), StatusAfterHours},
+		{"Saturday early morning (Friday session)", time.Date(2026, 1, 24, 2, 0, 0, 0, loc), StatusAfterHours},
+		{"Saturday", time.Date(2026, 1, 24, 10, 0, 0, 0, loc), StatusClosed},
+		{"Sunday early morning", time.Date(2026, 1, 25, 2, 0, 0, 0, loc), StatusClosed},
+		{"Evening before Good Friday", time.Date(2026, 4, 2, 20, 0, 0, 0, loc), StatusAfterHours},
+		{"Good Friday early morning", time.Date(2026, 4, 3, 2, 0, 0, 0, loc), StatusAfterHours},
+		{"Good Friday", time.Date(2026, 4, 3, 10, 0, 0, 0, loc), StatusClosed},
+		{"Good Friday evening", time.Date(2026, 4, 3, 20, 0, 0, 0, loc), StatusClosed},
+	}
+
+	for _, tt := range tests {
+		if status := derivatives.GetStatus(tt.time); status != tt.want {
+			t.Errorf("%s: expected status %s at %v, got %s", tt.desc, tt.want, tt.time, status)
+		}
+	}
+}
+
+func TestHKEXDerivatives_HalfDay(t *testing.T) {
+	derivatives := NewHKEXDerivatives()
+
+	loc, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	// Christmas Eve 2026: morning session until 12:30 PM, no after-hours session
+	if status := derivatives.GetStatus(time.Date(2026, 12, 24, 12, 15, 0, 0, loc)); status != StatusOpen {
+		t.Errorf("Expected status %s on Christmas Eve at 12:15 PM, got %s", StatusOpen, status)
+	}
+	if status := derivatives.GetStatus(time.Date(2026, 12, 24, 14, 0, 0, 0, loc)); status != StatusClosed {
+		t.Errorf("Expected status %s on Christmas Eve at 2:00 PM, got %s", StatusClosed, status)
+	}
+	if status := derivatives.GetStatus(time.Date(2026, 12, 24, 18, 0, 0, 0, loc)); status != StatusClosed {
+		t.Errorf("Expected status %s on Christmas Eve at 6:00 PM, got %s", StatusClosed, status)
+	}
+}
+
+func TestHKEXDerivatives_TradingDate(t *testing.T) {
+	derivatives := NewHKEXDerivatives()
+
+	loc, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	tests := []struct {
+		desc string
+		time time.Time
+		want time.Time
+	}{
+		{"Day session", time.Date(2026, 1, 19, 10, 0, 0, 0, loc), time.Date(2026, 1, 19, 0, 0, 0, 0, loc)},
+		{"Monday after-hours session", time.Date(2026, 1, 19, 20, 0, 0, 0, loc), time.Date(2026, 1, 20, 0, 0, 0, 0, loc)},
+		{"Friday after-hours session", time.Date(2026, 1, 24, 1, 0, 0, 0, loc), time.Date(2026, 1, 26, 0, 0, 0, 0, loc)},
+		{"After-hours session before Easter", time.Date(2026, 4, 2, 20, 0, 0, 0, loc), time.Date(2026, 4, 7, 0, 0, 0, 0, loc)},
+	}
+
+	for _, tt := range tests {
+		got, ok := tradingDateAt(derivatives, tt.time)
+		if !ok {
+			t.Errorf("%s: expected a trading date for %v", tt.desc, tt.time)
+			continue
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("%s: expected trading date %v, got %v", tt.desc, tt.want, got)
+		}
+	}
+
+	if _, ok := tradingDateAt(derivatives, time.Date(2026, 1, 24, 10, 0, 0, 0, loc)); ok {
+		t.Error("Expected no trading date on Saturday morning")
+	}
+}
+
+func TestHKEXDerivatives_Name(t *testing.T) {
+	derivatives := NewHKEXDerivatives()
+	if derivatives.Name() != "HKEX Derivatives" {
+		t.Errorf("Expected name 'HKEX Derivatives', got '%s'", derivatives.Name())
+	}
+}
@@ -10,25 +10,42 @@ type HolidayProvider interface {
 	IsHoliday(t time.Time) bool
 }
 
+// EarlyCloseProvider defines an interface for providing days with a shortened trading session
+type EarlyCloseProvider interface {
+	// IsEarlyClose checks if the given date is an early close day
+	IsEarlyClose(t time.Time) bool
+}
+
 // StaticHolidayProvider provides a simple static list of holidays
+// The list is assumed to be complete for every year it contains a holiday in.
 type StaticHolidayProvider struct {
 	holidays map[string]bool // key format: "YYYY-MM-DD"
+	years    map[int]bool
 }
 
 // NewStaticHolidayProvider creates a new static holiday provider
 func NewStaticHolidayProvider(holidays []time.Time) *StaticHolidayProvider {
 	holidayMap := make(map[string]bool)
+	years := make(map[int]bool)
 	for _, h := range holidays {
 		// Normalize to midnight in the holiday's location to ensure date-only comparison
 		normalized := time.Date(h.Year(), h.Month(), h.Day(), 0, 0, 0, 0, h.Location())
 		key := normalized.Format("2006-01-02")
 		holidayMap[key] = true
+		years[h.Year()] = true
 	}
 	return &StaticHolidayProvider{
 		holidays: holidayMap,
+		years:    years,
 	}
 }
 
+// Covers checks if the holiday list covers the year of the given date
+// An empty list covers every date.
+func (p *StaticHolidayProvider) Covers(t time.Time) bool {
+	return len(p.years) == 0 || p.years[t.Year()]
+}
+
 // IsHoliday checks if the given date is a holiday
 func (p *StaticHolidayProvider) IsHoliday(t time.Time) bool {
 	// Normalize to date only in the time's location
@@ -201,6 +218,353 @@ func calculateEaster(year int) time.Time {
 	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
 }
 
+// SIFMAHolidayProvider dynamically calculates the US bond market calendar recommended by SIFMA
+// Unlike the stock market, the bond market also closes on Columbus Day and Veterans Day.
+type SIFMAHolidayProvider struct {
+	location *time.Location
+}
+
+// NewSIFMAHolidayProvider creates a new holiday provider for the SIFMA US bond market calendar
+func NewSIFMAHolidayProvider(location *time.Location) *SIFMAHolidayProvider {
+	return &SIFMAHolidayProvider{
+		location: location,
+	}
+}
+
+// IsHoliday checks if the given date is a SIFMA recommended full close
+func (p *SIFMAHolidayProvider) IsHoliday(t time.Time) bool {
+	return p.holiday(t) != ""
+}
+
+// IsEarlyClose checks if the given date is a SIFMA recommended 2:00 PM early close
+// Early closes are recommended on the business day before most holidays, the
+// day after Thanksgiving and New Year's Eve.
+func (p *SIFMAHolidayProvider) IsEarlyClose(t time.Time) bool {
+	t = t.In(p.location)
+	if IsWeekend(t) || p.IsHoliday(t) {
+		return false
+	}
+
+	// New Year's Eve
+	if t.Month() == time.December && t.Day() == 31 {
+		return true
+	}
+
+	// Day after Thanksgiving
+	if p.holiday(t.AddDate(0, 0, -1)) == "Thanksgiving" {
+		return true
+	}
+
+	// Business day before a holiday (Columbus Day and Veterans Day excepted)
+	next := t.AddDate(0, 0, 1)
+	for IsWeekend(next) {
+		next = next.AddDate(0, 0, 1)
+	}
+	switch p.holiday(next) {
+	case "", "Columbus Day", "Veterans Day", "Thanksgiving":
+		return false
+	default:
+		return true
+	}
+}
+
+// holiday returns the name of the SIFMA holiday on the given date, or an empty string
+func (p *SIFMAHolidayProvider) holiday(t time.Time) string {
+	t = t.In(p.location)
+	year := t.Year()
+	month := t.Month()
+	day := t.Day()
+
+	// New Year's Day (observed on Monday if on Sunday, no Friday closure if on Saturday)
+	if isObservedOnNextMonday(year, time.January, 1, t, p.location) && time.Date(year, time.January, 1, 0, 0, 0, 0, p.location).Weekday() != time.Saturday {
+		return "New Year's Day"
+	}
+
+	if month == time.January && day == nthWeekdayOfMonth(year, time.January, time.Monday, 3) {
+		return "Martin Luther King Jr. Day"
+	}
+
+	if month == time.February && day == nthWeekdayOfMonth(year, time.February, time.Monday, 3) {
+		return "Presidents Day"
+	}
+
+	if isGoodFriday(year, month, day) {
+		return "Good Friday"
+	}
+
+	if month == time.May && day == lastWeekdayOfMonth(year, time.May, time.Monday) {
+		return "Memorial Day"
+	}
+
+	if isObservedHoliday(year, time.June, 19, t, p.location) {
+		return "Juneteenth"
+	}
+
+	if isObservedHoliday(year, time.July, 4, t, p.location) {
+		return "Independence Day"
+	}
+
+	if month == time.September && day == nthWeekdayOfMonth(year, time.September, time.Monday, 1) {
+		return "Labor Day"
+	}
+
+	// Columbus Day (2nd Monday in October)
+	if month == time.October && day == nthWeekdayOfMonth(year, time.October, time.Monday, 2) {
+		return "Columbus Day"
+	}
+
+	// Veterans Day (November 11, or observed on nearby weekday if on weekend)
+	if isObservedHoliday(year, time.November, 11, t, p.location) {
+		return "Veterans Day"
+	}
+
+	if month == time.November && day == nthWeekdayOfMonth(year, time.November, time.Thursday, 4) {
+		return "Thanksgiving"
+	}
+
+	if isObservedHoliday(year, time.December, 25, t, p.location) {
+		return "Christmas"
+	}
+
+	return ""
+}
+
+// TSXHolidayProvider dynamically calculates Toronto Stock Exchange holidays
+type TSXHolidayProvider struct {
+	location *time.Location
+}
+
+// NewTSXHolidayProvider creates a new holiday provider for the Toronto Stock Exchange
+func NewTSXHolidayProvider(location *time.Location) *TSXHolidayProvider {
+	return &TSXHolidayProvider{
+		location: location,
+	}
+}
+
+// IsHoliday checks if the given date is a TSX holiday
+func (p *TSXHolidayProvider) IsHoliday(t time.Time) bool {
+	t = t.In(p.location)
+	year := t.Year()
+	month := t.Month()
+	day := t.Day()
+
+	// New Year's Day (January 1, or the following Monday if on weekend)
+	if isObservedOnNextMonday(year, time.January, 1, t, p.location) {
+		return true
+	}
+
+	// Family Day (3rd Monday in February)
+	if month == time.February && day == nthWeekdayOfMonth(year, time.February, time.Monday, 3) {
+		return true
+	}
+
+	// Good Friday
+	if isGoodFriday(year, month, day) {
+		return true
+	}
+
+	// Victoria Day (last Monday preceding May 25)
+	if month == time.May && day == lastWeekdayOnOrBefore(year, time.May, 24, time.Monday) {
+		return true
+	}
+
+	// Canada Day (July 1, or the following Monday if on weekend)
+	if isObservedOnNextMonday(year, time.July, 1, t, p.location) {
+		return true
+	}
+
+	// Civic Holiday (1st Monday in August)
+	if month == time.August && day == nthWeekdayOfMonth(year, time.August, time.Monday, 1) {
+		return true
+	}
+
+	// Labour Day (1st Monday in September)
+	if month == time.September && day == nthWeekdayOfMonth(year, time.September, time.Monday, 1) {
+		return true
+	}
+
+	// Thanksgiving (2nd Monday in October)
+	if month == time.October && day == nthWeekdayOfMonth(year, time.October, time.Monday, 2) {
+		return true
+	}
+
+	// Christmas and Boxing Day (the first two weekdays on or after December 25)
+	if month == time.December && day >= 25 {
+		christmas := time.Date(year, time.December, 25, 0, 0, 0, 0, p.location)
+		for closed := 0; closed < 2; christmas = christmas.AddDate(0, 0, 1) {
+			if IsWeekend(christmas) {
+				continue
+			}
+			if christmas.Day() == day {
+				return true
+			}
+			closed++
+		}
+	}
+
+	return false
+}
+
+// B3HolidayProvider dynamically calculates B3 (Brasil, Bolsa, Balcão) holidays
+type B3HolidayProvider struct {
+	location *time.Location
+}
+
+// NewB3HolidayProvider creates a new holiday provider for B3
+func NewB3HolidayProvider(location *time.Location) *B3HolidayProvider {
+	return &B3HolidayProvider{
+		location: location,
+	}
+}
+
+// IsHoliday checks if the given date is a B3 holiday
+func (p *B3HolidayProvider) IsHoliday(t time.Time) bool {
+	t = t.In(p.location)
+	year := t.Year()
+	month := t.Month()
+	day := t.Day()
+
+	// Brazilian national holidays are not moved when they fall on a weekend
+	fixed := []struct {
+		month time.Month
+		day   int
+	}{
+		{time.January, 1},   // Confraternização Universal
+		{time.April, 21},    // Tiradentes
+		{time.May, 1},       // Dia do Trabalho
+		{time.September, 7}, // Independência do Brasil
+		{time.October, 12},  // Nossa Senhora Aparecida
+		{time.November, 2},  // Finados
+		{time.November, 15}, // Proclamação da República
+		{time.December, 24}, // Christmas Eve (no trading session)
+		{time.December, 25}, // Natal
+	}
+	for _, h := range fixed {
+		if month == h.month && day == h.day {
+			return true
+		}
+	}
+
+	// Dia Nacional de Zumbi e da Consciência Negra (national holiday since 2024)
+	if year >= 2024 && month == time.November && day == 20 {
+		return true
+	}
+
+	// Carnival Monday and Tuesday (48 and 47 days before Easter)
+	if isEasterRelative(year, month, day, -48) || isEasterRelative(year, month, day, -47) {
+		return true
+	}
+
+	// Good Friday
+	if isGoodFriday(year, month, day) {
+		return true
+	}
+
+	// Corpus Christi (60 days after Easter)
+	if isEasterRelative(year, month, day, 60) {
+		return true
+	}
+
+	// No trading session on the last weekday of the year
+	if month == time.December && day == lastWeekdayOfYear(year) {
+		return true
+	}
+
+	return false
+}
+
+// BMVHolidayProvider dynamically calculates Bolsa Mexicana de Valores holidays
+type BMVHolidayProvider struct {
+	location *time.Location
+}
+
+// NewBMVHolidayProvider creates a new holiday provider for the Mexican Stock Exchange
+func NewBMVHolidayProvider(location *time.Location) *BMVHolidayProvider {
+	return &BMVHolidayProvider{
+		location: location,
+	}
+}
+
+// IsHoliday checks if the given date is a BMV holiday
+func (p *BMVHolidayProvider) IsHoliday(t time.Time) bool {
+	t = t.In(p.location)
+	year := t.Year()
+	month := t.Month()
+	day := t.Day()
+
+	fixed := []struct {
+		month time.Month
+		day   int
+	}{
+		{time.January, 1},    // Año Nuevo
+		{time.May, 1},        // Día del Trabajo
+		{time.September, 16}, // Día de la Independencia
+		{time.November, 2},   // Día de Muertos
+		{time.December, 12},  // Día de la Virgen de Guadalupe
+		{time.December, 25},  // Navidad
+	}
+	for _, h := range fixed {
+		if month == h.month && day == h.day {
+			return true
+		}
+	}
+
+	// Constitution Day (1st Monday in February)
+	if month == time.February && day == nthWeekdayOfMonth(year, time.February, time.Monday, 1) {
+		return true
+	}
+
+	// Benito Juárez's Birthday (3rd Monday in March)
+	if month == time.March && day == nthWeekdayOfMonth(year, time.March, time.Monday, 3) {
+		return true
+	}
+
+	// Holy Thursday and Good Friday
+	if isEasterRelative(year, month, day, -3) || isGoodFriday(year, month, day) {
+		return true
+	}
+
+	// Revolution Day (3rd Monday in November)
+	if month == time.November && day == nthWeekdayOfMonth(year, time.November, time.Monday, 3) {
+		return true
+	}
+
+	return false
+}
+
+// lastWeekdayOnOrBefore returns the day of month for the last occurrence of a weekday on or before the given day
+func lastWeekdayOnOrBefore(year int, month time.Month, day int, weekday time.Weekday) int {
+	t := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	for t.Weekday() != weekday {
+		t = t.AddDate(0, 0, -1)
+	}
+	return t.Day()
+}
+
+// lastWeekdayOfYear returns the day of December of the last Monday-Friday day of the year
+func lastWeekdayOfYear(year int) int {
+	t := time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC)
+	for IsWeekend(t) {
+		t = t.AddDate(0, 0, -1)
+	}
+	return t.Day()
+}
+
+// isObservedOnNextMonday checks if a date is a holiday that moves to the following Monday when it falls on a weekend
+func isObservedOnNextMonday(year int, month time.Month, day int, t time.Time, loc *time.Location) bool {
+	holiday := time.Date(year, month, day, 0, 0, 0, 0, loc)
+	for IsWeekend(holiday) {
+		holiday = holiday.AddDate(0, 0, 1)
+	}
+	return t.Year() == holiday.Year() && t.Month() == holiday.Month() && t.Day() == holiday.Day()
+}
+
+// isEasterRelative checks if the date is the given number of days from Easter Sunday
+func isEasterRelative(year int, month time.Month, day int, offset int) bool {
+	target := calculateEaster(year).AddDate(0, 0, offset)
+	return month == target.Month() && day == target.Day()
+}
+
 // HKEX holidays - includes both 2025 and 2026 (lunar calendar dates require manual specification)
 var hkexHolidays = []time.Time{
 	// 2025
@@ -241,6 +605,30 @@ var hkexHolidays = []time.Time{
 	time.Date(2026, 12, 26, 0, 0, 0, 0, mustLoadLocation("Asia/Hong_Kong")), // Boxing Day
 }
 
+// HKEX half trading days - the eves of Christmas, New Year and Lunar New Year when they fall on weekdays
+var hkexHalfDays = []time.Time{
+	// 2025
+	time.Date(2025, 1, 28, 0, 0, 0, 0, mustLoadLocation("Asia/Hong_Kong")),  // Lunar New Year's Eve
+	time.Date(2025, 12, 24, 0, 0, 0, 0, mustLoadLocation("Asia/Hong_Kong")), // Christmas Eve
+	time.Date(2025, 12, 31, 0, 0, 0, 0, mustLoadLocation("Asia/Hong_Kong")), // New Year's Eve
+	// 2026
+	time.Date(2026, 2, 16, 0, 0, 0, 0, mustLoadLocation("Asia/Hong_Kong")),  // Lunar New Year's Eve
+	time.Date(2026, 12, 24, 0, 0, 0, 0, mustLoadLocation("Asia/Hong_Kong")), // Christmas Eve
+	time.Date(2026, 12, 31, 0, 0, 0, 0, mustLoadLocation("Asia/Hong_Kong")), // New Year's Eve
+}
+
+// Southbound Stock Connect exception days - trading days of both HKEX and the mainland exchanges
+// on which Southbound does not open because Hong Kong trades a half day. Days on
+// which the mainland exchanges are closed anyway are not listed.
+var southboundConnectExceptions = []time.Time{
+	// 2025
+	time.Date(2025, 12, 24, 0, 0, 0, 0, mustLoadLocation("Asia/Hong_Kong")), // Christmas Eve
+	time.Date(2025, 12, 31, 0, 0, 0, 0, mustLoadLocation("Asia/Hong_Kong")), // New Year's Eve
+	// 2026
+	time.Date(2026, 12, 24, 0, 0, 0, 0, mustLoadLocation("Asia/Hong_Kong")), // Christmas Eve
+	time.Date(2026, 12, 31, 0, 0, 0, 0, mustLoadLocation("Asia/Hong_Kong")), // New Year's Eve
+}
+
 // China A-Share holidays - includes both 2025 and 2026 (lunar calendar dates require manual specification)
 var chinaAShareHolidays = []time.Time{
 	// 2025
@@ -299,24 +687,3 @@ var chinaAShareHolidays = []time.Time{
 	time.Date(2026, 10, 7, 0, 0, 0, 0, mustLoadLocation("Asia/Shanghai")), // National Day
 	time.Date(2026, 10, 8, 0, 0, 0, 0, mustLoadLocation("Asia/Shanghai")), // National Day
 }
-
-// mustLoadLocation loads a timezone location or panics if it fails
-func mustLoadLocation(name string) *time.Location {
-	loc, err := time.LoadLocation(name)
-	if err != nil {
-		// Fallback for environments without timezone data.
-		// Note: These fixed offsets don't account for DST, but since holidays
-		// are compared by date only (not time), this is acceptable for the fallback case.
-		switch name {
-		case "America/New_York":
-			return time.FixedZone("ET", -5*3600) // Approximation without DST
-		case "Asia/Hong_Kong":
-			return time.FixedZone("HKT", 8*3600)
-		case "Asia/Shanghai":
-			return time.FixedZone("CST", 8*3600)
-		default:
-			panic(err)
-		}
-	}
-	return loc
-}
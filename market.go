@@ -18,6 +18,8 @@ const (
 	StatusPostmarket MarketStatus = "postmarket"
 	// StatusOvernight indicates the market is in overnight trading session
 	StatusOvernight MarketStatus = "overnight"
+	// StatusAfterHours indicates the market is in an after-hours trading session
+	StatusAfterHours MarketStatus = "afterhours"
 )
 
 // Market represents a financial market
@@ -32,21 +34,21 @@ type Market interface {
 
 // TimeRange represents a trading session time range
 type TimeRange struct {
-	Start time.Duration // Duration from midnight
-	End   time.Duration // Duration from midnight
+	Start TimeOfDay // Wall-clock start (inclusive)
+	End   TimeOfDay // Wall-clock end (exclusive), before Start if the range crosses midnight
 }
 
 // IsWithin checks if the given time is within the range
+// The range is compared with the wall-clock time of t in its location, so
+// boundaries stay put on days when daylight saving time starts or ends.
 func (tr TimeRange) IsWithin(t time.Time) bool {
-	loc := t.Location()
-	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
-	sinceStart := t.Sub(midnight)
+	now := TimeOfDayOf(t)
 
 	// Handle ranges that cross midnight
-	if tr.End < tr.Start {
-		return sinceStart >= tr.Start || sinceStart < tr.End
+	if tr.End.Before(tr.Start) {
+		return !now.Before(tr.Start) || now.Before(tr.End)
 	}
-	return sinceStart >= tr.Start && sinceStart < tr.End
+	return !now.Before(tr.Start) && now.Before(tr.End)
 }
 
 // IsWeekend checks if the given time is on a weekend
@@ -0,0 +1,162 @@
+package marketchecker
+
+import (
+	"sort"
+	"strings"
+)
+
+// MarketInfo describes a market's venue identifiers
+type MarketInfo struct {
+	Market       MarketType
+	MIC          string   // ISO 10383 market identifier code, empty if the market has no venue of its own
+	OperatingMIC string   // ISO 10383 operating MIC of the exchange group
+	Country      string   // ISO 3166-1 alpha-2 country code
+	Aliases      []string // Other names, including segment MICs that share the market's schedule
+}
+
+// marketInfos lists the identifiers of the built-in markets
+// Where several markets share a MIC, the first one listed is the primary market for it.
+var marketInfos = []MarketInfo{
+	{Market: MarketNASDAQ, MIC: "XNAS", OperatingMIC: "XNAS", Country: "US", Aliases: []string{"XNGS", "XNMS", "XNCM"}},
+	{Market: MarketCboeEquityOptions, MIC: "XCBO", OperatingMIC: "XCBO", Country: "US", Aliases: []string{"CBOE"}},
+	{Market: MarketCboeETFOptions, MIC: "XCBO", OperatingMIC: "XCBO", Country: "US"},
+	{Market: MarketCboeIndexOptions, MIC: "XCBO", OperatingMIC: "XCBO", Country: "US"},
+	{Market: MarketUSBonds, Country: "US", Aliases: []string{"SIFMA"}},
+
+	{Market: MarketHKEX, MIC: "XHKG", OperatingMIC: "XHKG", Country: "HK", Aliases: []string{"SEHK"}},
+	{Market: MarketHKEXDerivatives, MIC: "XHKF", OperatingMIC: "XHKG", Country: "HK", Aliases: []string{"HKFE"}},
+
+	{Market: MarketSSE, MIC: "XSHG", OperatingMIC: "XSHG", Country: "CN", Aliases: []string{"SHSE"}},
+	{Market: MarketSZSE, MIC: "XSHE", OperatingMIC: "XSHE", Country: "CN"},
+	{Market: MarketChinaAShare, Country: "CN", Aliases: []string{"A-Share"}},
+	{Market: MarketChinaMainBoard, Country: "CN"},
+	{Market: MarketChinaSTAR, OperatingMIC: "XSHG", Country: "CN", Aliases: []string{"STAR"}},
+	{Market: MarketChinaChiNext, OperatingMIC: "XSHE", Country: "CN", Aliases: []string{"ChiNext"}},
+	{Market: MarketChinaBSE, MIC: "BJSE", OperatingMIC: "BJSE", Country: "CN"},
+
+	// Futures product groups trade on their exchange's venue under different hours, so the
+	// exchange MIC identifies no single schedule and is only recorded as the operating MIC
+	{Market: MarketSHFEPreciousMetals, OperatingMIC: "XSGE", Country: "CN"},
+	{Market: MarketSHFENonFerrousMetals, OperatingMIC: "XSGE", Country: "CN"},
+	{Market: MarketSHFEOtherCommodities, OperatingMIC: "XSGE", Country: "CN"},
+	{Market: MarketSHFEDayOnly, OperatingMIC: "XSGE", Country: "CN"},
+	{Market: MarketDCENightTrading, OperatingMIC: "XDCE", Country: "CN"},
+	{Market: MarketDCEDayOnly, OperatingMIC: "XDCE", Country: "CN"},
+	{Market: MarketZCENightTrading, OperatingMIC: "XZCE", Country: "CN"},
+	{Market: MarketZCEDayOnly, OperatingMIC: "XZCE", Country: "CN"},
+	{Market: MarketCFFEXEquityIndex, OperatingMIC: "CCFX", Country: "CN"},
+	{Market: MarketCFFEXTreasury, OperatingMIC: "CCFX", Country: "CN"},
+	{Market: MarketINECrudeOil, OperatingMIC: "XINE", Country: "CN"},
+	{Market: MarketINEInternationalCopper, OperatingMIC: "XINE", Country: "CN"},
+	{Market: MarketINEOtherCommodities, OperatingMIC: "XINE", Country: "CN"},
+	{Market: MarketINEDayOnly, OperatingMIC: "XINE", Country: "CN"},
+
+	// Northbound trades mainland securities via the SSE (XSSC) and SZSE (XSEC) Connect segments,
+	// Southbound trades Hong Kong securities via the HKEX segments for Shanghai (SHSC) and Shenzhen (SZSC)
+	{Market: MarketStockConnectNorthbound, MIC: "XSSC", OperatingMIC: "XSHG", Country: "CN", Aliases: []string{"XSEC"}},
+	{Market: MarketStockConnectSouthbound, MIC: "SHSC", OperatingMIC: "XHKG", Country: "HK", Aliases: []string{"SZSC"}},
+
+	{Market: MarketTSX, MIC: "XTSE", OperatingMIC: "XTSE", Country: "CA", Aliases: []string{"TSE"}},
+	{Market: MarketB3, MIC: "BVMF", OperatingMIC: "BVMF", Country: "BR", Aliases: []string{"BOVESPA"}},
+	{Market: MarketBMV, MIC: "XMEX", OperatingMIC: "XMEX", Country: "MX"},
+}
+
+// marketNames maps upper-cased market types, MICs and aliases to market types
+var marketNames = buildMarketNames()
+
+// buildMarketNames indexes the market types, MICs and aliases of marketInfos
+// Earlier entries take precedence, so a shared MIC resolves to its primary market.
+func buildMarketNames() map[string]MarketType {
+	names := make(map[string]MarketType)
+	add := func(name string, market MarketType) {
+		key := strings.ToUpper(name)
+		if _, ok := names[key]; name != "" && !ok {
+			names[key] = market
+		}
+	}
+	// Market types win over MICs and aliases
+	for _, info := range marketInfos {
+		add(string(info.Market), info.Market)
+	}
+	for _, info := range marketInfos {
+		add(info.MIC, info.Market)
+		for _, alias := range info.Aliases {
+			add(alias, info.Market)
+		}
+	}
+	return names
+}
+
+// ParseMarketType returns the built-in market type for a market type name, MIC, operating MIC or alias
+// The lookup is case-insensitive. An operating MIC resolves to the market whose MIC it is,
+// e.g. "XHKG" to HKEX. Returns an UnknownMarketError if the name is not recognized.
+func ParseMarketType(name string) (MarketType, error) {
+	market, ok := marketNames[strings.ToUpper(strings.TrimSpace(name))]
+	if !ok {
+		return "", &UnknownMarketError{Market: MarketType(name)}
+	}
+	return market, nil
+}
+
+// LookupMarketInfo returns the identifiers of the specified built-in market
+func LookupMarketInfo(market MarketType) (MarketInfo, bool) {
+	for _, info := range marketInfos {
+		if info.Market == market {
+			return info, true
+		}
+	}
+	return MarketInfo{}, false
+}
+
+// MarketsByCountry returns the built-in markets of an ISO 3166-1 alpha-2 country code in sorted order
+// The lookup is case-insensitive.
+func MarketsByCountry(country string) []MarketType {
+	var markets []MarketType
+	for _, info := range marketInfos {
+		if strings.EqualFold(info.Country, country) {
+			markets = append(markets, info.Market)
+		}
+	}
+	sort.Slice(markets, func(i, j int) bool {
+		return markets[i] < markets[j]
+	})
+	return markets
+}
+
+// MarketsByOperatingMIC returns the built-in markets operated under an ISO 10383 operating MIC in sorted order
+// The lookup is case-insensitive.
+func MarketsByOperatingMIC(operatingMIC string) []MarketType {
+	var markets []MarketType
+	for _, info := range marketInfos {
+		if strings.EqualFold(info.OperatingMIC, operatingMIC) {
+			markets = append(markets, info.Market)
+		}
+	}
+	sort.Slice(markets, func(i, j int) bool {
+		return markets[i] < markets[j]
+	})
+	return markets
+}
+
+// ResolveMarket returns the market type registered in the checker for a market type name, MIC or alias
+// Registered market types match exactly, including custom ones; other names are parsed with ParseMarketType.
+func (c *Checker) ResolveMarket(name string) (MarketType, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.resolve(MarketType(name))
+}
+
+// resolve returns the registered market type for a market type, MIC or alias; c.mu must be held
+func (c *Checker) resolve(marketType MarketType) (MarketType, error) {
+	if _, ok := c.markets[marketType]; ok {
+		return marketType, nil
+	}
+	parsed, err := ParseMarketType(string(marketType))
+	if err != nil {
+		return "", err
+	}
+	if _, ok := c.markets[parsed]; !ok {
+		return "", &UnknownMarketError{Market: marketType}
+	}
+	return parsed, nil
+}
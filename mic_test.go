@@ -0,0 +1,174 @@
+package marketchecker
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseMarketType(t *testing.T) {
+	tests := []struct {
+		name string
+		want MarketType
+	}{
+		{"XNAS", MarketNASDAQ},
+		{"xngs", MarketNASDAQ},
+		{"XHKG", MarketHKEX},
+		{"xhkf", MarketHKEXDerivatives},
+		{"XSHG", MarketSSE},
+		{"XSHE", MarketSZSE},
+		{"BJSE", MarketChinaBSE},
+		{"XSSC", MarketStockConnectNorthbound},
+		{"XSEC", MarketStockConnectNorthbound},
+		{"SZSC", MarketStockConnectSouthbound},
+		{"XCBO", MarketCboeEquityOptions},
+		{"XTSE", MarketTSX},
+		{"BVMF", MarketB3},
+		{"XMEX", MarketBMV},
+		{"nasdaq", MarketNASDAQ},
+		{"ChinaAShare", MarketChinaAShare},
+		{" sehk ", MarketHKEX},
+		{"a-share", MarketChinaAShare},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseMarketType(tt.name)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", tt.name, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%q: expected %s, got %s", tt.name, tt.want, got)
+		}
+	}
+
+	_, err := ParseMarketType("XXXX")
+	if !errors.Is(err, ErrUnknownMarket) {
+		t.Errorf("Expected ErrUnknownMarket, got %v", err)
+	}
+
+	// Futures exchange MICs are shared by product groups with different hours
+	if _, err := ParseMarketType("XSGE"); !errors.Is(err, ErrUnknownMarket) {
+		t.Errorf("Expected ErrUnknownMarket for an exchange-wide futures MIC, got %v", err)
+	}
+}
+
+func TestMarketInfo_CoversBuiltInMarkets(t *testing.T) {
+	checker := NewChecker()
+
+	for _, marketType := range checker.Markets() {
+		info, ok := LookupMarketInfo(marketType)
+		if !ok {
+			t.Errorf("%s: no market info", marketType)
+			continue
+		}
+		if len(info.Country) != 2 {
+			t.Errorf("%s: invalid country code %q", marketType, info.Country)
+		}
+		if info.MIC != "" && len(info.MIC) != 4 {
+			t.Errorf("%s: invalid MIC %q", marketType, info.MIC)
+		}
+		if parsed, err := ParseMarketType(string(marketType)); err != nil || parsed != marketType {
+			t.Errorf("%s: expected market type to parse to itself, got %s (%v)", marketType, parsed, err)
+		}
+	}
+}
+
+func TestMarketsByCountry(t *testing.T) {
+	got := MarketsByCountry("hk")
+	want := []MarketType{MarketHKEX, MarketHKEXDerivatives, MarketStockConnectSouthbound}
+	if len(got) != len(want) {
+		t.Fatalf("Expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Expected %v, got %v", want, got)
+			break
+		}
+	}
+
+	if got := MarketsByOperatingMIC("XSHG"); len(got) != 3 {
+		t.Errorf("Expected SSE, STAR Market and Northbound under XSHG, got %v", got)
+	}
+	if got := MarketsByOperatingMIC("ccfx"); len(got) != 2 || got[0] != MarketCFFEXEquityIndex || got[1] != MarketCFFEXTreasury {
+		t.Errorf("Expected both CFFEX product groups under CCFX, got %v", got)
+	}
+	if got := MarketsByCountry("ZZ"); len(got) != 0 {
+		t.Errorf("Expected no markets for unknown country, got %v", got)
+	}
+}
+
+func TestChecker_AcceptsMICs(t *testing.T) {
+	checker := NewChecker()
+
+	sh, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+	now := time.Date(2026, 1, 26, 10, 0, 0, 0, sh)
+
+	for _, mic := range []MarketType{"XSHG", "XSHE", "xhkg"} {
+		open, err := checker.IsOpen(mic, now)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", mic, err)
+		}
+		if !open {
+			t.Errorf("%s: expected market to be open", mic)
+		}
+	}
+
+	settlement, err := checker.SettlementDate("XHKG", now)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if settlement.Day() != 28 {
+		t.Errorf("Expected HKEX T+2 settlement on Jan 28, got %v", settlement)
+	}
+
+	resolved, err := checker.ResolveMarket("xshe")
+	if err != nil || resolved != MarketSZSE {
+		t.Errorf("Expected SZSE, got %s (%v)", resolved, err)
+	}
+
+	// Registered custom markets take precedence over the MIC registry
+	checker.AddMarket("XNAS", NewHKEX())
+	market, err := checker.GetMarket("XNAS")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if market.Name() != "HKEX" {
+		t.Errorf("Expected custom market registered as XNAS, got %s", market.Name())
+	}
+
+	// MICs of removed markets are unknown
+	if err := checker.RemoveMarket(MarketBMV); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if _, err := checker.GetMarket("XMEX"); !errors.Is(err, ErrUnknownMarket) {
+		t.Errorf("Expected ErrUnknownMarket for removed market, got %v", err)
+	}
+}
+
+func TestChinaAShareExchange(t *testing.T) {
+	sse := NewChinaAShareExchange(ExchangeSSE)
+	szse := NewChinaAShareExchange(ExchangeSZSE)
+	combined := NewChinaAShare()
+
+	if sse.Name() != "China A-Share SSE" || sse.Exchange() != ExchangeSSE {
+		t.Errorf("Unexpected SSE market: %s (%s)", sse.Name(), sse.Exchange())
+	}
+	if combined.Exchange() != "" {
+		t.Errorf("Expected combined market without exchange, got %s", combined.Exchange())
+	}
+
+	from := time.Date(2026, 2, 13, 0, 0, 0, 0, time.UTC)
+	for ts := from; ts.Before(from.AddDate(0, 0, 7)); ts = ts.Add(30 * time.Minute) {
+		want := combined.GetStatus(ts)
+		if got := sse.GetStatus(ts); got != want {
+			t.Errorf("SSE at %v: expected %s, got %s", ts, want, got)
+		}
+		if got := szse.GetStatus(ts); got != want {
+			t.Errorf("SZSE at %v: expected %s, got %s", ts, want, got)
+		}
+	}
+}
@@ -15,12 +15,7 @@ var (
 )
 
 func init() {
-	var err error
-	nasdaqLocation, err = time.LoadLocation("America/New_York")
-	if err != nil {
-		// Fallback to UTC if location loading fails
-		nasdaqLocation = time.UTC
-	}
+	nasdaqLocation = mustLoadLocation("America/New_York")
 }
 
 // NewNASDAQ creates a new NASDAQ market instance
@@ -35,6 +30,11 @@ func (n *NASDAQ) Name() string {
 	return "NASDAQ"
 }
 
+// Location returns the market timezone
+func (n *NASDAQ) Location() *time.Location {
+	return nasdaqLocation
+}
+
 // IsOpen checks if NASDAQ is open for regular trading at the given time
 func (n *NASDAQ) IsOpen(t time.Time) bool {
 	status := n.GetStatus(t)
@@ -43,74 +43,57 @@ func (n *NASDAQ) IsOpen(t time.Time) bool {
 
 // GetStatus returns the current market status at the given time
 func (n *NASDAQ) GetStatus(t time.Time) MarketStatus {
-	// Convert to Eastern Time
-	localTime := t.In(nasdaqLocation)
+	return statusAt(n, t)
+}
+
+// SessionsOn returns the NASDAQ trading sessions belonging to the given trading date
+// Overnight trading from 8:00 PM the evening before belongs to the trading date.
+func (n *NASDAQ) SessionsOn(date time.Time) []Session {
+	day := startOfDay(date, nasdaqLocation)
+	if !n.IsTradingDay(day) {
+		return nil
+	}
 
 	// Define trading session times (in Eastern Time)
 	// Overnight: 8:00 PM - 4:00 AM (previous day 20:00 to current day 04:00)
+	// The overnight session starting on Sunday evening or on a holiday
+	// evening belongs to the next trading day
 	overnight := TimeRange{
-		Start: 20 * time.Hour,
-		End:   4 * time.Hour,
+		Start: TimeOfDay{Hour: 20},
+		End:   TimeOfDay{Hour: 4},
 	}
 
 	// Premarket: 4:00 AM - 9:30 AM
 	premarket := TimeRange{
-		Start: 4 * time.Hour,
-		End:   9*time.Hour + 30*time.Minute,
+		Start: TimeOfDay{Hour: 4},
+		End:   TimeOfDay{Hour: 9, Minute: 30},
 	}
 
 	// Regular trading: 9:30 AM - 4:00 PM
 	regular := TimeRange{
-		Start: 9*time.Hour + 30*time.Minute,
-		End:   16 * time.Hour,
+		Start: TimeOfDay{Hour: 9, Minute: 30},
+		End:   TimeOfDay{Hour: 16},
 	}
 
 	// Postmarket: 4:00 PM - 8:00 PM
 	postmarket := TimeRange{
-		Start: 16 * time.Hour,
-		End:   20 * time.Hour,
+		Start: TimeOfDay{Hour: 16},
+		End:   TimeOfDay{Hour: 20},
 	}
 
-	// Check if it's in overnight session BEFORE holiday/weekend checks
-	// because Sunday 8PM-midnight transitions to Monday's overnight session
-	if overnight.IsWithin(localTime) {
-		hour := localTime.Hour()
-		if hour >= 20 { // 8 PM or later (8 PM to midnight)
-			// For 8 PM to midnight, check if NEXT day is a weekday and not a holiday
-			nextDay := localTime.AddDate(0, 0, 1)
-			if !IsWeekend(nextDay) && (n.holidayProvider == nil || !n.holidayProvider.IsHoliday(nextDay)) {
-				return StatusOvernight
-			}
-		} else if hour < 4 { // Before 4 AM (midnight to 4 AM)
-			// For midnight to 4 AM, check if CURRENT day is a weekday and not a holiday
-			if !IsWeekend(localTime) && (n.holidayProvider == nil || !n.holidayProvider.IsHoliday(localTime)) {
-				return StatusOvernight
-			}
-		}
+	return []Session{
+		newSession(n.Name(), "overnight", StatusOvernight, day.AddDate(0, 0, -1), overnight, day),
+		newSession(n.Name(), "premarket", StatusPremarket, day, premarket, day),
+		newSession(n.Name(), "regular", StatusOpen, day, regular, day),
+		newSession(n.Name(), "postmarket", StatusPostmarket, day, postmarket, day),
 	}
+}
 
-	// Check if it's a holiday (for regular/premarket/postmarket sessions)
+// IsTradingDay checks if the given date is a NASDAQ trading day
+func (n *NASDAQ) IsTradingDay(t time.Time) bool {
+	localTime := t.In(nasdaqLocation)
 	if n.holidayProvider != nil && n.holidayProvider.IsHoliday(localTime) {
-		return StatusClosed
+		return false
 	}
-
-	// Check if it's weekend
-	if IsWeekend(localTime) {
-		return StatusClosed
-	}
-
-	// Check each session
-	if regular.IsWithin(localTime) {
-		return StatusOpen
-	}
-
-	if premarket.IsWithin(localTime) {
-		return StatusPremarket
-	}
-
-	if postmarket.IsWithin(localTime) {
-		return StatusPostmarket
-	}
-
-	return StatusClosed
+	return !IsWeekend(localTime)
 }
@@ -0,0 +1,133 @@
+package marketchecker
+
+import (
+	"time"
+)
+
+// Window is an absolute time interval [Start, End)
+type Window struct {
+	Start time.Time
+	End   time.Time
+}
+
+// Duration returns the length of the window
+func (w Window) Duration() time.Duration {
+	return w.End.Sub(w.Start)
+}
+
+// Contains checks if the given time is within the window
+func (w Window) Contains(t time.Time) bool {
+	return !t.Before(w.Start) && t.Before(w.End)
+}
+
+// OverlapWindows returns the intervals within [from, to) during which all of the specified markets are in one of the statuses of statusFilter
+// An empty statusFilter matches regular trading (StatusOpen) only. Windows are returned in chronological order.
+func (c *Checker) OverlapWindows(markets []MarketType, from, to time.Time, statusFilter []MarketStatus) ([]Window, error) {
+	if len(markets) == 0 || !from.Before(to) {
+		return nil, nil
+	}
+	if len(statusFilter) == 0 {
+		statusFilter = []MarketStatus{StatusOpen}
+	}
+
+	schedules := make([]SessionSchedule, 0, len(markets))
+	for _, marketType := range markets {
+		schedule, err := c.sessionSchedule(marketType, from, to)
+		if err != nil {
+			return nil, err
+		}
+		schedules = append(schedules, schedule)
+	}
+
+	var overlap []Window
+	for i, schedule := range schedules {
+		windows := statusWindows(schedule, from, to, statusFilter)
+		if i == 0 {
+			overlap = windows
+		} else {
+			overlap = intersectWindows(overlap, windows)
+		}
+		if len(overlap) == 0 {
+			return nil, nil
+		}
+	}
+	return overlap, nil
+}
+
+// AllOpen checks if all of the specified markets are open at the given time
+func (c *Checker) AllOpen(t time.Time, markets ...MarketType) (bool, error) {
+	allOpen := true
+	for _, marketType := range markets {
+		open, err := c.IsOpen(marketType, t)
+		if err != nil {
+			return false, err
+		}
+		allOpen = allOpen && open
+	}
+	return allOpen, nil
+}
+
+// AnyOpen checks if any of the specified markets is open at the given time
+func (c *Checker) AnyOpen(t time.Time, markets ...MarketType) (bool, error) {
+	anyOpen := false
+	for _, marketType := range markets {
+		open, err := c.IsOpen(marketType, t)
+		if err != nil {
+			return false, err
+		}
+		anyOpen = anyOpen || open
+	}
+	return anyOpen, nil
+}
+
+// statusWindows returns the merged intervals within [from, to) during which the schedule is in one of the given statuses
+func statusWindows(schedule SessionSchedule, from, to time.Time, statuses []MarketStatus) []Window {
+	var windows []Window
+	for _, session := range sessionsBetween(schedule, from, to) {
+		if !containsStatus(statuses, session.Status) {
+			continue
+		}
+		start, end := session.Start, session.End
+		if start.Before(from) {
+			start = from
+		}
+		if end.After(to) {
+			end = to
+		}
+		if !start.Before(end) {
+			continue
+		}
+		// Adjacent sessions, e.g. regular trading followed by postmarket, form one window
+		if n := len(windows); n > 0 && !start.After(windows[n-1].End) {
+			if end.After(windows[n-1].End) {
+				windows[n-1].End = end
+			}
+			continue
+		}
+		windows = append(windows, Window{Start: start, End: end})
+	}
+	return windows
+}
+
+// intersectWindows returns the intersection of two chronologically ordered, non-overlapping window lists
+func intersectWindows(a, b []Window) []Window {
+	var result []Window
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		start, end := a[i].Start, a[i].End
+		if b[j].Start.After(start) {
+			start = b[j].Start
+		}
+		if b[j].End.Before(end) {
+			end = b[j].End
+		}
+		if start.Before(end) {
+			result = append(result, Window{Start: start, End: end})
+		}
+		if a[i].End.Before(b[j].End) {
+			i++
+		} else {
+			j++
+		}
+	}
+	return result
+}
@@ -0,0 +1,128 @@
+package marketchecker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestChecker_OverlapWindows(t *testing.T) {
+	checker := NewChecker()
+
+	hk, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	from := time.Date(2026, 1, 26, 0, 0, 0, 0, hk)
+	to := time.Date(2026, 1, 27, 0, 0, 0, 0, hk)
+
+	// China A-Share closes earlier than HKEX on both sessions
+	windows, err := checker.OverlapWindows([]MarketType{MarketHKEX, MarketChinaAShare}, from, to, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	want := []Window{
+		{time.Date(2026, 1, 26, 9, 30, 0, 0, hk), time.Date(2026, 1, 26, 11, 30, 0, 0, hk)},
+		{time.Date(2026, 1, 26, 13, 0, 0, 0, hk), time.Date(2026, 1, 26, 15, 0, 0, 0, hk)},
+	}
+	if len(windows) != len(want) {
+		t.Fatalf("Expected %d windows, got %d: %v", len(want), len(windows), windows)
+	}
+	for i := range want {
+		if !windows[i].Start.Equal(want[i].Start) || !windows[i].End.Equal(want[i].End) {
+			t.Errorf("Window %d: expected %v - %v, got %v - %v", i, want[i].Start, want[i].End, windows[i].Start, windows[i].End)
+		}
+	}
+
+	// NASDAQ and HKEX regular sessions never overlap
+	windows, err = checker.OverlapWindows([]MarketType{MarketHKEX, MarketNASDAQ}, from, to, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(windows) != 0 {
+		t.Errorf("Expected no overlap of regular sessions, got %v", windows)
+	}
+
+	// HKEX regular trading overlaps NASDAQ overnight trading
+	windows, err = checker.OverlapWindows([]MarketType{MarketHKEX, MarketNASDAQ}, from, to, []MarketStatus{StatusOpen, StatusOvernight})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	var total time.Duration
+	for _, w := range windows {
+		total += w.Duration()
+	}
+	if want := 5*time.Hour + 30*time.Minute; total != want {
+		t.Errorf("Expected %v of overlap, got %v (%v)", want, total, windows)
+	}
+
+	// Windows are clipped to the range
+	windows, err = checker.OverlapWindows([]MarketType{MarketHKEX}, time.Date(2026, 1, 26, 10, 0, 0, 0, hk), time.Date(2026, 1, 26, 11, 0, 0, 0, hk), nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(windows) != 1 || windows[0].Duration() != time.Hour {
+		t.Errorf("Expected a single 1h window, got %v", windows)
+	}
+
+	if _, err := checker.OverlapWindows([]MarketType{MarketHKEX, "UNKNOWN"}, from, to, nil); err == nil {
+		t.Error("Expected error for unknown market type")
+	}
+}
+
+func TestStatusWindows_MergesAdjacentSessions(t *testing.T) {
+	nasdaq := NewNASDAQ()
+
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	from := time.Date(2026, 1, 27, 0, 0, 0, 0, loc)
+	to := time.Date(2026, 1, 28, 0, 0, 0, 0, loc)
+	windows := statusWindows(nasdaq, from, to, []MarketStatus{StatusPremarket, StatusOpen, StatusPostmarket})
+	if len(windows) != 1 {
+		t.Fatalf("Expected a single window, got %v", windows)
+	}
+	if !windows[0].Start.Equal(time.Date(2026, 1, 27, 4, 0, 0, 0, loc)) || !windows[0].End.Equal(time.Date(2026, 1, 27, 20, 0, 0, 0, loc)) {
+		t.Errorf("Expected 04:00 - 20:00, got %v - %v", windows[0].Start, windows[0].End)
+	}
+}
+
+func TestChecker_AllOpenAnyOpen(t *testing.T) {
+	checker := NewChecker()
+
+	hk, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	tests := []struct {
+		desc    string
+		time    time.Time
+		wantAll bool
+		wantAny bool
+	}{
+		{"both open", time.Date(2026, 1, 26, 10, 0, 0, 0, hk), true, true},
+		{"only HKEX open", time.Date(2026, 1, 26, 15, 30, 0, 0, hk), false, true},
+		{"both closed", time.Date(2026, 1, 26, 12, 15, 0, 0, hk), false, false},
+	}
+
+	for _, tt := range tests {
+		all, err := checker.AllOpen(tt.time, MarketHKEX, MarketChinaAShare)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.desc, err)
+		}
+		anyOpen, err := checker.AnyOpen(tt.time, MarketHKEX, MarketChinaAShare)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.desc, err)
+		}
+		if all != tt.wantAll || anyOpen != tt.wantAny {
+			t.Errorf("%s: expected all=%v any=%v, got all=%v any=%v", tt.desc, tt.wantAll, tt.wantAny, all, anyOpen)
+		}
+	}
+
+	if _, err := checker.AnyOpen(time.Now(), MarketHKEX, "UNKNOWN"); err == nil {
+		t.Error("Expected error for unknown market type")
+	}
+}
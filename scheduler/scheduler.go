@@ -0,0 +1,181 @@
+// Package scheduler runs jobs at times defined relative to market events, such as the open and close of a market
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	marketchecker "github.com/uranuswch/trading-market-hour-checker"
+)
+
+// Job is the work run by the scheduler
+// at is the scheduled run time, which may be slightly earlier than the clock when the job starts.
+type Job func(ctx context.Context, at time.Time)
+
+// Scheduler runs jobs at times defined relative to market events
+type Scheduler struct {
+	checker *marketchecker.Checker
+	mu      sync.Mutex
+	entries []*entry
+	wake    chan struct{} // Signaled when a job is scheduled so that Run picks it up
+	onError func(job string, err error)
+}
+
+// entry is a scheduled job and its next run time
+type entry struct {
+	name    string
+	trigger Trigger
+	job     Job
+	next    time.Time
+	hasNext bool
+}
+
+// New creates a new Scheduler using the markets and the clock of the given checker
+func New(checker *marketchecker.Checker) *Scheduler {
+	return &Scheduler{checker: checker, wake: make(chan struct{}, 1)}
+}
+
+// Schedule adds a job that runs whenever the trigger fires
+// Jobs can be added while Run is running. Returns an error if the trigger
+// cannot be evaluated, e.g. for an unknown market.
+func (s *Scheduler) Schedule(name string, trigger Trigger, job Job) error {
+	if job == nil {
+		return fmt.Errorf("job %s has no function", name)
+	}
+	e := &entry{name: name, trigger: trigger, job: job}
+	if err := s.advance(e, s.checker.Clock().Now()); err != nil {
+		return fmt.Errorf("job %s: %w", name, err)
+	}
+
+	s.mu.Lock()
+	s.entries = append(s.entries, e)
+	s.mu.Unlock()
+
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// OnError sets the function called by Run when the trigger of a job fails
+// The job stops running while the other jobs carry on, e.g. when a market's
+// holiday data runs out. The handler is called from the goroutine running Run.
+func (s *Scheduler) OnError(handler func(job string, err error)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.onError = handler
+}
+
+// Next returns the next run time of the named job
+// The boolean result is false if the job is unknown or never runs again.
+func (s *Scheduler) Next(name string) (time.Time, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, e := range s.entries {
+		if e.name == name {
+			return e.next, e.hasNext
+		}
+	}
+	return time.Time{}, false
+}
+
+// Run runs the scheduled jobs until ctx is done
+// Jobs due at the same time run one after another in the order they were scheduled;
+// long-running jobs should start their own goroutines. Missed runs are not caught up:
+// if the clock jumps past several runs of a job, e.g. after the machine slept, the job
+// runs once for its earliest missed run and then resumes with its next run after the
+// current time. If the trigger of a job fails, that job stops running and the error is
+// passed to the OnError handler; the other jobs keep running. Run returns ctx.Err() when ctx is done.
+func (s *Scheduler) Run(ctx context.Context) error {
+	clock := s.checker.Clock()
+	for {
+		s.mu.Lock()
+		var next time.Time
+		for _, e := range s.entries {
+			if e.hasNext && (next.IsZero() || e.next.Before(next)) {
+				next = e.next
+			}
+		}
+		s.mu.Unlock()
+
+		var timer marketchecker.Timer
+		var fired <-chan time.Time
+		if !next.IsZero() {
+			timer = clock.NewTimer(next.Sub(clock.Now()))
+			fired = timer.C()
+		}
+		select {
+		case <-ctx.Done():
+			if timer != nil {
+				timer.Stop()
+			}
+			return ctx.Err()
+		case <-s.wake:
+			// A job was scheduled; recompute the earliest run
+			if timer != nil {
+				timer.Stop()
+			}
+			continue
+		case <-fired:
+		}
+
+		now := later(next, clock.Now())
+		for _, e := range s.due(now) {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			e.job(ctx, e.next)
+			if err := s.advance(e, later(e.next, now)); err != nil {
+				s.fail(e, err)
+			}
+		}
+	}
+}
+
+// later returns the later of two times
+func later(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+// due returns the jobs whose next run is at or before the given time
+func (s *Scheduler) due(t time.Time) []*entry {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var due []*entry
+	for _, e := range s.entries {
+		if e.hasNext && !e.next.After(t) {
+			due = append(due, e)
+		}
+	}
+	return due
+}
+
+// advance computes the next run of the job after t
+// The job has no next run if the trigger fails.
+func (s *Scheduler) advance(e *entry, t time.Time) error {
+	next, ok, err := e.trigger.Next(s.checker, t)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err != nil {
+		e.next, e.hasNext = time.Time{}, false
+		return err
+	}
+	e.next, e.hasNext = next, ok
+	return nil
+}
+
+// fail reports the trigger error of a job to the OnError handler
+func (s *Scheduler) fail(e *entry, err error) {
+	s.mu.Lock()
+	handler := s.onError
+	s.mu.Unlock()
+	if handler != nil {
+		handler(e.name, err)
+	}
+}
@@ -0,0 +1,232 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	marketchecker "github.com/uranuswch/trading-market-hour-checker"
+)
+
+// earliestNext returns the earliest next run of the named jobs
+func earliestNext(t *testing.T, s *Scheduler, names ...string) time.Time {
+	t.Helper()
+	var next time.Time
+	for _, name := range names {
+		at, ok := s.Next(name)
+		if ok && (next.IsZero() || at.Before(next)) {
+			next = at
+		}
+	}
+	if next.IsZero() {
+		t.Fatalf("Expected one of %v to have a next run", names)
+	}
+	return next
+}
+
+// recorder returns a job that reports its runs on the channel
+func recorder(runs chan<- string, name string, loc *time.Location) Job {
+	return func(ctx context.Context, at time.Time) {
+		runs <- name + " " + at.In(loc).Format("2006-01-02 15:04")
+	}
+}
+
+func TestScheduler_Run(t *testing.T) {
+	hk, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	clock := marketchecker.NewFakeClock(time.Date(2026, 2, 13, 17, 0, 0, 0, hk))
+	s := New(marketchecker.NewChecker(marketchecker.WithClock(clock)))
+
+	runs := make(chan string, 1)
+	if err := s.Schedule("pre-open", AtOpen(marketchecker.MarketHKEX, -5*time.Minute), recorder(runs, "pre-open", hk)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := s.Schedule("close", AtClose(marketchecker.MarketHKEX, 0), recorder(runs, "close", hk)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if next, ok := s.Next("pre-open"); !ok || !next.Equal(time.Date(2026, 2, 16, 9, 25, 0, 0, hk)) {
+		t.Errorf("Expected next pre-open run on 2026-02-16 09:25, got %v (ok=%v)", next, ok)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- s.Run(ctx) }()
+
+	// Lunar New Year: a half day on Feb 16, then closed until Feb 20
+	want := []string{
+		"pre-open 2026-02-16 09:25",
+		"close 2026-02-16 12:00",
+		"pre-open 2026-02-20 09:25",
+		"close 2026-02-20 16:00",
+		"pre-open 2026-02-23 09:25",
+		"close 2026-02-23 16:00",
+	}
+	for i := range want {
+		clock.BlockUntil(1)
+		clock.Set(earliestNext(t, s, "pre-open", "close"))
+		if got := <-runs; got != want[i] {
+			t.Errorf("Run %d: expected %q, got %q", i, want[i], got)
+		}
+	}
+
+	clock.BlockUntil(1)
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
+func TestScheduler_RunAfterClockJump(t *testing.T) {
+	hk, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	clock := marketchecker.NewFakeClock(time.Date(2026, 2, 13, 17, 0, 0, 0, hk))
+	s := New(marketchecker.NewChecker(marketchecker.WithClock(clock)))
+
+	runs := make(chan string, 10)
+	if err := s.Schedule("pre-open", AtOpen(marketchecker.MarketHKEX, -5*time.Minute), recorder(runs, "pre-open", hk)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// The clock jumps a week and a half, past four pre-open runs
+	clock.Set(time.Date(2026, 2, 24, 17, 0, 0, 0, hk))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- s.Run(ctx) }()
+
+	// The job runs once for the earliest missed run, then resumes after the current time
+	if got, want := <-runs, "pre-open 2026-02-16 09:25"; got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+	clock.BlockUntil(1)
+	if next, ok := s.Next("pre-open"); !ok || !next.Equal(time.Date(2026, 2, 25, 9, 25, 0, 0, hk)) {
+		t.Errorf("Expected next pre-open run on 2026-02-25 09:25, got %v (ok=%v)", next, ok)
+	}
+	if len(runs) != 0 {
+		t.Errorf("Expected missed runs to be skipped, got %d more", len(runs))
+	}
+
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
+func TestScheduler_ScheduleWhileRunning(t *testing.T) {
+	hk, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	clock := marketchecker.NewFakeClock(time.Date(2026, 1, 19, 8, 0, 0, 0, hk))
+	s := New(marketchecker.NewChecker(marketchecker.WithClock(clock)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- s.Run(ctx) }()
+
+	// Run starts without any jobs and must pick up the new one
+	runs := make(chan string, 1)
+	if err := s.Schedule("open", AtOpen(marketchecker.MarketHKEX, 0), recorder(runs, "open", hk)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	clock.BlockUntil(1)
+	clock.Set(time.Date(2026, 1, 19, 9, 30, 0, 0, hk))
+	if got, want := <-runs, "open 2026-01-19 09:30"; got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+
+	clock.BlockUntil(1)
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
+func TestScheduler_ScheduleErrors(t *testing.T) {
+	s := New(marketchecker.NewChecker())
+
+	if err := s.Schedule("unknown", AtOpen("UNKNOWN", 0), func(context.Context, time.Time) {}); err == nil {
+		t.Error("Expected error for unknown market type")
+	}
+	if err := s.Schedule("nil", AtOpen(marketchecker.MarketHKEX, 0), nil); err == nil {
+		t.Error("Expected error for nil job")
+	}
+	if _, ok := s.Next("unknown"); ok {
+		t.Error("Expected unknown job not to be scheduled")
+	}
+}
+
+// failingTrigger fires once at the given time and then fails
+type failingTrigger struct {
+	at  time.Time
+	err error
+}
+
+func (f failingTrigger) Next(checker *marketchecker.Checker, after time.Time) (time.Time, bool, error) {
+	if after.Before(f.at) {
+		return f.at, true, nil
+	}
+	return time.Time{}, false, f.err
+}
+
+func TestScheduler_RunAfterTriggerError(t *testing.T) {
+	hk, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	clock := marketchecker.NewFakeClock(time.Date(2026, 1, 19, 8, 0, 0, 0, hk))
+	s := New(marketchecker.NewChecker(marketchecker.WithClock(clock)))
+
+	failures := make(chan string, 1)
+	s.OnError(func(job string, err error) {
+		failures <- job + ": " + err.Error()
+	})
+
+	runs := make(chan string, 1)
+	failing := failingTrigger{at: time.Date(2026, 1, 19, 9, 0, 0, 0, hk), err: errors.New("no more runs")}
+	if err := s.Schedule("failing", failing, recorder(runs, "failing", hk)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := s.Schedule("open", AtOpen(marketchecker.MarketHKEX, 0), recorder(runs, "open", hk)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- s.Run(ctx) }()
+
+	clock.BlockUntil(1)
+	clock.Set(time.Date(2026, 1, 19, 9, 0, 0, 0, hk))
+	if got, want := <-runs, "failing 2026-01-19 09:00"; got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+	if got, want := <-failures, "failing: no more runs"; got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+	if _, ok := s.Next("failing"); ok {
+		t.Error("Expected the failing job to have no next run")
+	}
+
+	// The other job keeps running
+	clock.BlockUntil(1)
+	clock.Set(time.Date(2026, 1, 19, 9, 30, 0, 0, hk))
+	if got, want := <-runs, "open 2026-01-19 09:30"; got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+
+	clock.BlockUntil(1)
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
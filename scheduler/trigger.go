@@ -0,0 +1,170 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	marketchecker "github.com/uranuswch/trading-market-hour-checker"
+)
+
+// maxSearchDays bounds the number of calendar days scanned when looking for the next run
+const maxSearchDays = 366
+
+// Trigger determines when a job runs
+type Trigger interface {
+	// Next returns the first run time strictly after the given time
+	// The boolean result is false if the trigger never fires again.
+	Next(checker *marketchecker.Checker, after time.Time) (time.Time, bool, error)
+}
+
+// eventTrigger fires once per trading date relative to the open or close of the regular session
+type eventTrigger struct {
+	market  marketchecker.MarketType
+	atClose bool
+	offset  time.Duration
+}
+
+// AtOpen fires at the open of the market's regular trading on each trading date, shifted by offset
+// A negative offset fires before the open, e.g. AtOpen(MarketHKEX, -5*time.Minute).
+// Markets with a lunch break open once per trading date, at the start of the morning session.
+func AtOpen(market marketchecker.MarketType, offset time.Duration) Trigger {
+	return eventTrigger{market: market, offset: offset}
+}
+
+// AtClose fires at the close of the market's regular trading on each trading date, shifted by offset
+// The close is read from the market's sessions, so it follows half trading days
+// only for markets whose sessions model them, such as HKEX; markets without
+// early closes in their sessions, such as NASDAQ and Cboe, fire at the usual close.
+func AtClose(market marketchecker.MarketType, offset time.Duration) Trigger {
+	return eventTrigger{market: market, atClose: true, offset: offset}
+}
+
+// Next returns the first open or close of a trading date, shifted by the offset, strictly after the given time
+func (e eventTrigger) Next(checker *marketchecker.Checker, after time.Time) (time.Time, bool, error) {
+	loc, err := marketLocation(checker, e.market)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	// The event of the day before may still be ahead once the offset is applied
+	event := after.Add(-e.offset).In(loc)
+	date := time.Date(event.Year(), event.Month(), event.Day(), 0, 0, 0, 0, loc).AddDate(0, 0, -1)
+	date, err = checker.AddTradingDays(e.market, date, 0)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	for i := 0; i < maxSearchDays; i++ {
+		openAt, closeAt, ok, err := regularHours(checker, e.market, date)
+		if err != nil {
+			return time.Time{}, false, err
+		}
+		if ok {
+			at := openAt
+			if e.atClose {
+				at = closeAt
+			}
+			at = at.Add(e.offset)
+			if at.After(after) {
+				return at, true, nil
+			}
+		}
+
+		date, err = checker.NextTradingDay(e.market, date)
+		if err != nil {
+			return time.Time{}, false, err
+		}
+	}
+	return time.Time{}, false, nil
+}
+
+// everyTrigger fires at a fixed interval during the market's regular sessions
+type everyTrigger struct {
+	market   marketchecker.MarketType
+	interval time.Duration
+}
+
+// Every fires every interval while the market is in regular trading
+// Runs are aligned to the start of each session, so a market with a lunch break restarts the count after the break.
+func Every(market marketchecker.MarketType, interval time.Duration) Trigger {
+	return everyTrigger{market: market, interval: interval}
+}
+
+// Next returns the first aligned run strictly after the given time that falls within a regular session
+func (e everyTrigger) Next(checker *marketchecker.Checker, after time.Time) (time.Time, bool, error) {
+	if e.interval <= 0 {
+		return time.Time{}, false, fmt.Errorf("invalid interval: %s", e.interval)
+	}
+
+	loc, err := marketLocation(checker, e.market)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	// Sessions are queried one calendar day at a time, like regularHours, so
+	// that runs up to the end of the market's holiday data can be found.
+	from := after
+	for i := 0; i < maxSearchDays; i++ {
+		local := from.In(loc)
+		to := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc).AddDate(0, 0, 1)
+		sessions, err := checker.Sessions(e.market, from, to)
+		if err != nil {
+			return time.Time{}, false, err
+		}
+
+		for _, session := range sessions {
+			if session.Status != marketchecker.StatusOpen {
+				continue
+			}
+			if session.Start.After(after) {
+				return session.Start, true, nil
+			}
+			at := session.Start.Add((after.Sub(session.Start)/e.interval + 1) * e.interval)
+			if at.Before(session.End) {
+				return at, true, nil
+			}
+		}
+		from = to
+	}
+	return time.Time{}, false, nil
+}
+
+// regularHours returns the open and close of regular trading on the given trading date
+// The boolean result is false if the market has no regular session on that date.
+func regularHours(checker *marketchecker.Checker, market marketchecker.MarketType, date time.Time) (time.Time, time.Time, bool, error) {
+	// Sessions of a trading date may start the evening before. The range stays
+	// within the days around the date so that the last covered trading date of
+	// a holiday calendar can be queried.
+	sessions, err := checker.Sessions(market, date.AddDate(0, 0, -1), date.AddDate(0, 0, 1))
+	if err != nil {
+		return time.Time{}, time.Time{}, false, err
+	}
+
+	var openAt, closeAt time.Time
+	found := false
+	for _, session := range sessions {
+		if session.Status != marketchecker.StatusOpen || !session.TradingDate.Equal(date) {
+			continue
+		}
+		if !found {
+			openAt = session.Start
+			found = true
+		}
+		closeAt = session.End
+	}
+	return openAt, closeAt, found, nil
+}
+
+// marketLocation returns the timezone of the market's trading calendar
+func marketLocation(checker *marketchecker.Checker, market marketchecker.MarketType) (*time.Location, error) {
+	m, err := checker.GetMarket(market)
+	if err != nil {
+		return nil, err
+	}
+	calendar, ok := m.(marketchecker.TradingCalendar)
+	if !ok {
+		return nil, fmt.Errorf("market %s does not provide a trading calendar: %w", market, errors.ErrUnsupported)
+	}
+	return calendar.Location(), nil
+}
@@ -0,0 +1,150 @@
+package scheduler
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	marketchecker "github.com/uranuswch/trading-market-hour-checker"
+)
+
+func TestAtOpen(t *testing.T) {
+	checker := marketchecker.NewChecker()
+
+	hk, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	tests := []struct {
+		desc    string
+		trigger Trigger
+		after   time.Time
+		want    time.Time
+	}{
+		{"5 minutes before HKEX open skips the weekend", AtOpen(marketchecker.MarketHKEX, -5*time.Minute), time.Date(2026, 1, 23, 10, 0, 0, 0, hk), time.Date(2026, 1, 26, 9, 25, 0, 0, hk)},
+		{"5 minutes before HKEX open later the same day", AtOpen(marketchecker.MarketHKEX, -5*time.Minute), time.Date(2026, 1, 26, 8, 0, 0, 0, hk), time.Date(2026, 1, 26, 9, 25, 0, 0, hk)},
+		{"HKEX open skips Lunar New Year", AtOpen(marketchecker.MarketHKEX, 0), time.Date(2026, 2, 16, 10, 0, 0, 0, hk), time.Date(2026, 2, 20, 9, 30, 0, 0, hk)},
+		{"NASDAQ open across the DST change", AtOpen(marketchecker.MarketNASDAQ, 0), time.Date(2026, 3, 6, 10, 0, 0, 0, ny), time.Date(2026, 3, 9, 9, 30, 0, 0, ny)},
+		{"NASDAQ close skips Thanksgiving", AtClose(marketchecker.MarketNASDAQ, 0), time.Date(2026, 11, 25, 17, 0, 0, 0, ny), time.Date(2026, 11, 27, 16, 0, 0, 0, ny)},
+		{"HKEX close on a half day", AtClose(marketchecker.MarketHKEX, 0), time.Date(2026, 12, 24, 9, 0, 0, 0, hk), time.Date(2026, 12, 24, 12, 0, 0, 0, hk)},
+		{"after HKEX close", AtClose(marketchecker.MarketHKEX, time.Minute), time.Date(2026, 1, 26, 16, 0, 30, 0, hk), time.Date(2026, 1, 26, 16, 1, 0, 0, hk)},
+	}
+
+	for _, tt := range tests {
+		got, ok, err := tt.trigger.Next(checker, tt.after)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.desc, err)
+		}
+		if !ok || !got.Equal(tt.want) {
+			t.Errorf("%s: expected %v, got %v (ok=%v)", tt.desc, tt.want, got, ok)
+		}
+	}
+}
+
+func TestEvery(t *testing.T) {
+	checker := marketchecker.NewChecker()
+
+	sh, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	trigger := Every(marketchecker.MarketChinaAShare, 30*time.Second)
+
+	tests := []struct {
+		desc  string
+		after time.Time
+		want  time.Time
+	}{
+		{"before the open", time.Date(2026, 1, 26, 9, 0, 0, 0, sh), time.Date(2026, 1, 26, 9, 30, 0, 0, sh)},
+		{"aligned to the session start", time.Date(2026, 1, 26, 9, 30, 10, 0, sh), time.Date(2026, 1, 26, 9, 30, 30, 0, sh)},
+		{"on a run", time.Date(2026, 1, 26, 9, 30, 30, 0, sh), time.Date(2026, 1, 26, 9, 31, 0, 0, sh)},
+		{"lunch break", time.Date(2026, 1, 26, 11, 29, 45, 0, sh), time.Date(2026, 1, 26, 13, 0, 0, 0, sh)},
+		{"Friday close to Monday open", time.Date(2026, 1, 23, 14, 59, 30, 0, sh), time.Date(2026, 1, 26, 9, 30, 0, 0, sh)},
+	}
+
+	for _, tt := range tests {
+		got, ok, err := trigger.Next(checker, tt.after)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.desc, err)
+		}
+		if !ok || !got.Equal(tt.want) {
+			t.Errorf("%s: expected %v, got %v (ok=%v)", tt.desc, tt.want, got, ok)
+		}
+	}
+
+	if _, _, err := Every(marketchecker.MarketChinaAShare, 0).Next(checker, time.Now()); err == nil {
+		t.Error("Expected error for non-positive interval")
+	}
+}
+
+func TestTriggerUnknownMarket(t *testing.T) {
+	checker := marketchecker.NewChecker()
+
+	if _, _, err := AtOpen("UNKNOWN", 0).Next(checker, time.Now()); err == nil {
+		t.Error("Expected error for unknown market type")
+	}
+	if _, _, err := Every("UNKNOWN", time.Minute).Next(checker, time.Now()); err == nil {
+		t.Error("Expected error for unknown market type")
+	}
+}
+
+func TestTriggerAtEndOfCalendarCoverage(t *testing.T) {
+	checker := marketchecker.NewChecker()
+
+	hk, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	// New Year's Eve is the last trading date of the HKEX holiday data, a half day
+	next, ok, err := AtClose(marketchecker.MarketHKEX, 0).Next(checker, time.Date(2026, 12, 30, 17, 0, 0, 0, hk))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if want := time.Date(2026, 12, 31, 12, 0, 0, 0, hk); !ok || !next.Equal(want) {
+		t.Errorf("Expected %v, got %v (ok=%v)", want, next, ok)
+	}
+
+	// Beyond it the trigger reports the missing holiday data
+	if _, _, err := AtClose(marketchecker.MarketHKEX, 0).Next(checker, next); !errors.Is(err, marketchecker.ErrCalendarNotCovered) {
+		t.Errorf("Expected ErrCalendarNotCovered, got %v", err)
+	}
+}
+
+func TestEveryInLastCoveredWeek(t *testing.T) {
+	checker := marketchecker.NewChecker()
+
+	hk, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	// The week ahead runs past the end of the HKEX holiday data
+	trigger := Every(marketchecker.MarketHKEX, 30*time.Second)
+	next, ok, err := trigger.Next(checker, time.Date(2026, 12, 28, 10, 0, 0, 0, hk))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if want := time.Date(2026, 12, 28, 10, 0, 30, 0, hk); !ok || !next.Equal(want) {
+		t.Errorf("Expected %v, got %v (ok=%v)", want, next, ok)
+	}
+
+	// The last run of the data is at the end of the New Year's Eve half day
+	next, ok, err = trigger.Next(checker, time.Date(2026, 12, 31, 11, 59, 0, 0, hk))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if want := time.Date(2026, 12, 31, 11, 59, 30, 0, hk); !ok || !next.Equal(want) {
+		t.Errorf("Expected %v, got %v (ok=%v)", want, next, ok)
+	}
+
+	if _, _, err := trigger.Next(checker, next); !errors.Is(err, marketchecker.ErrCalendarNotCovered) {
+		t.Errorf("Expected ErrCalendarNotCovered, got %v", err)
+	}
+}
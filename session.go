@@ -0,0 +1,154 @@
+package marketchecker
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Session is a trading session of a market
+// Its JSON encoding is part of the versioned schema, see SchemaVersion.
+type Session struct {
+	Market      string       `json:"marketName"`  // Market name, for display
+	Name        string       `json:"name"`        // Session name, e.g. "regular", "morning" or "overnight"
+	Status      MarketStatus `json:"status"`      // Market status while the session is in progress
+	Start       time.Time    `json:"start"`       // Start of the session (inclusive)
+	End         time.Time    `json:"end"`         // End of the session (exclusive)
+	TradingDate time.Time    `json:"tradingDate"` // Midnight of the trading date the session belongs to, in the market's timezone
+}
+
+// Contains checks if the given time is within the session
+func (s Session) Contains(t time.Time) bool {
+	return !t.Before(s.Start) && t.Before(s.End)
+}
+
+// SessionSchedule is implemented by markets that can list their trading sessions
+// All built-in markets implement SessionSchedule and derive GetStatus from it.
+type SessionSchedule interface {
+	TradingCalendar
+	// SessionsOn returns the sessions belonging to the given trading date in chronological order
+	// It returns nil if the date is not a trading day.
+	SessionsOn(date time.Time) []Session
+}
+
+// newSession creates a session from a wall-clock time range starting on the given calendar day
+// Ranges that cross midnight end on the following day.
+func newSession(market, name string, status MarketStatus, day time.Time, tr TimeRange, tradingDate time.Time) Session {
+	endDay := day
+	if !tr.Start.Before(tr.End) {
+		endDay = day.AddDate(0, 0, 1)
+	}
+	return Session{
+		Market:      market,
+		Name:        name,
+		Status:      status,
+		Start:       tr.Start.On(day, day.Location()),
+		End:         tr.End.On(endDay, day.Location()),
+		TradingDate: tradingDate,
+	}
+}
+
+// sessionAt returns the session of the schedule in progress at the given time
+func sessionAt(schedule SessionSchedule, t time.Time) (Session, bool) {
+	day := startOfDay(t, schedule.Location())
+
+	// A session belongs to the trading date it is held on, or to the next
+	// trading date when it starts the evening before (e.g. overnight trading)
+	candidates := []time.Time{day.AddDate(0, 0, -1), day}
+	if next, err := stepTradingDay(schedule, day, 1); err == nil {
+		candidates = append(candidates, next)
+	}
+
+	for _, date := range candidates {
+		for _, session := range schedule.SessionsOn(date) {
+			if session.Contains(t) {
+				return session, true
+			}
+		}
+	}
+	return Session{}, false
+}
+
+// sessionsBetween returns the sessions of the schedule overlapping the range [from, to) in chronological order
+func sessionsBetween(schedule SessionSchedule, from, to time.Time) []Session {
+	if !from.Before(to) {
+		return nil
+	}
+
+	loc := schedule.Location()
+	first := startOfDay(from, loc).AddDate(0, 0, -1)
+	last := startOfDay(to, loc)
+	// Include the next trading date, whose sessions may start the evening before
+	if next, err := stepTradingDay(schedule, last, 1); err == nil {
+		last = next
+	}
+
+	var sessions []Session
+	for date := first; !date.After(last); date = date.AddDate(0, 0, 1) {
+		for _, session := range schedule.SessionsOn(date) {
+			if session.End.After(from) && session.Start.Before(to) {
+				sessions = append(sessions, session)
+			}
+		}
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].Start.Before(sessions[j].Start)
+	})
+	return sessions
+}
+
+// statusAt returns the market status of the schedule at the given time
+func statusAt(schedule SessionSchedule, t time.Time) MarketStatus {
+	if session, ok := sessionAt(schedule, t); ok {
+		return session.Status
+	}
+	return StatusClosed
+}
+
+// tradingDateAt returns the trading date of the session in progress at the given time
+func tradingDateAt(schedule SessionSchedule, t time.Time) (time.Time, bool) {
+	if session, ok := sessionAt(schedule, t); ok {
+		return session.TradingDate, true
+	}
+	return time.Time{}, false
+}
+
+// Sessions returns the sessions of the specified market overlapping the range [from, to) in chronological order
+// Sessions are returned whole, i.e. they are not clipped to the range.
+func (c *Checker) Sessions(marketType MarketType, from, to time.Time) ([]Session, error) {
+	schedule, err := c.sessionSchedule(marketType, from, to)
+	if err != nil {
+		return nil, err
+	}
+	return sessionsBetween(schedule, from, to), nil
+}
+
+// TradingDate returns the trading date the given time belongs to in the specified market
+// The boolean result is false if the market is closed at the given time.
+func (c *Checker) TradingDate(marketType MarketType, t time.Time) (time.Time, bool, error) {
+	schedule, err := c.sessionSchedule(marketType, t, t)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	date, ok := tradingDateAt(schedule, t)
+	return date, ok, nil
+}
+
+// sessionSchedule returns the session schedule of the specified market after checking that its holiday data covers [from, to)
+// Pass the same time twice to check a single point in time.
+func (c *Checker) sessionSchedule(marketType MarketType, from, to time.Time) (SessionSchedule, error) {
+	market, err := c.GetMarket(marketType)
+	if err != nil {
+		return nil, err
+	}
+	schedule, ok := market.(SessionSchedule)
+	if !ok {
+		return nil, fmt.Errorf("market %s does not provide a session schedule: %w", marketType, errors.ErrUnsupported)
+	}
+	if err := checkRangeCoverage(schedule, from, to); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
@@ -0,0 +1,172 @@
+package marketchecker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNASDAQ_TradingDate(t *testing.T) {
+	nasdaq := NewNASDAQ()
+
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	tests := []struct {
+		desc   string
+		time   time.Time
+		want   time.Time
+		wantOK bool
+	}{
+		{"Sunday overnight belongs to Monday", time.Date(2026, 1, 25, 21, 0, 0, 0, loc), time.Date(2026, 1, 26, 0, 0, 0, 0, loc), true},
+		{"Monday overnight after midnight", time.Date(2026, 1, 26, 2, 0, 0, 0, loc), time.Date(2026, 1, 26, 0, 0, 0, 0, loc), true},
+		{"Monday postmarket", time.Date(2026, 1, 26, 19, 0, 0, 0, loc), time.Date(2026, 1, 26, 0, 0, 0, 0, loc), true},
+		{"Monday overnight belongs to Tuesday", time.Date(2026, 1, 26, 21, 0, 0, 0, loc), time.Date(2026, 1, 27, 0, 0, 0, 0, loc), true},
+		{"MLK Day evening belongs to Tuesday", time.Date(2026, 1, 19, 20, 0, 0, 0, loc), time.Date(2026, 1, 20, 0, 0, 0, 0, loc), true},
+		{"Friday evening is closed", time.Date(2026, 1, 23, 21, 0, 0, 0, loc), time.Time{}, false},
+		{"Saturday is closed", time.Date(2026, 1, 24, 12, 0, 0, 0, loc), time.Time{}, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := tradingDateAt(nasdaq, tt.time)
+		if ok != tt.wantOK {
+			t.Errorf("%s: expected ok %v, got %v", tt.desc, tt.wantOK, ok)
+			continue
+		}
+		if ok && !got.Equal(tt.want) {
+			t.Errorf("%s: expected trading date %v, got %v", tt.desc, tt.want, got)
+		}
+	}
+}
+
+func TestChecker_TradingDate(t *testing.T) {
+	checker := NewChecker()
+
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	// Global Trading Hours on Sunday evening belong to Monday
+	date, ok, err := checker.TradingDate(MarketCboeIndexOptions, time.Date(2026, 1, 25, 21, 0, 0, 0, loc))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !ok || !date.Equal(time.Date(2026, 1, 26, 0, 0, 0, 0, loc)) {
+		t.Errorf("Expected trading date 2026-01-26, got %v (ok=%v)", date, ok)
+	}
+
+	// HKEX lunch break has no trading date
+	hkLoc, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+	if _, ok, _ := checker.TradingDate(MarketHKEX, time.Date(2026, 1, 19, 12, 30, 0, 0, hkLoc)); ok {
+		t.Error("Expected no trading date during the HKEX lunch break")
+	}
+
+	if _, _, err := checker.TradingDate("UnknownMarket", time.Now()); err == nil {
+		t.Error("Expected error for unknown market type")
+	}
+
+	checker.AddMarket("StatusOnly", statusOnlyMarket{})
+	if _, _, err := checker.TradingDate("StatusOnly", time.Now()); err == nil {
+		t.Error("Expected error for market without a session schedule")
+	}
+}
+
+func TestSessionSchedule_ConsistentWithGetStatus(t *testing.T) {
+	checker := NewChecker()
+
+	// A week with a mainland holiday, a Hong Kong half day and ordinary US trading
+	from := time.Date(2026, 2, 12, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+
+	for _, marketType := range checker.Markets() {
+		market, err := checker.GetMarket(marketType)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		schedule, ok := market.(SessionSchedule)
+		if !ok {
+			t.Errorf("%s: built-in market does not implement SessionSchedule", marketType)
+			continue
+		}
+		for ts := from; ts.Before(to); ts = ts.Add(15 * time.Minute) {
+			status := market.GetStatus(ts)
+			_, hasDate := tradingDateAt(schedule, ts)
+			if (status != StatusClosed) != hasDate {
+				t.Errorf("%s at %v: status %s but trading date ok=%v", marketType, ts, status, hasDate)
+			}
+		}
+	}
+}
+
+func TestChecker_Sessions(t *testing.T) {
+	checker := NewChecker()
+
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	// Friday to Monday: Friday's four sessions plus Monday's overnight (starting Sunday) and premarket
+	from := time.Date(2026, 1, 23, 0, 0, 0, 0, loc)
+	to := time.Date(2026, 1, 26, 9, 0, 0, 0, loc)
+
+	sessions, err := checker.Sessions(MarketNASDAQ, from, to)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	want := []struct {
+		name        string
+		start       time.Time
+		end         time.Time
+		tradingDate time.Time
+	}{
+		{"overnight", time.Date(2026, 1, 22, 20, 0, 0, 0, loc), time.Date(2026, 1, 23, 4, 0, 0, 0, loc), time.Date(2026, 1, 23, 0, 0, 0, 0, loc)},
+		{"premarket", time.Date(2026, 1, 23, 4, 0, 0, 0, loc), time.Date(2026, 1, 23, 9, 30, 0, 0, loc), time.Date(2026, 1, 23, 0, 0, 0, 0, loc)},
+		{"regular", time.Date(2026, 1, 23, 9, 30, 0, 0, loc), time.Date(2026, 1, 23, 16, 0, 0, 0, loc), time.Date(2026, 1, 23, 0, 0, 0, 0, loc)},
+		{"postmarket", time.Date(2026, 1, 23, 16, 0, 0, 0, loc), time.Date(2026, 1, 23, 20, 0, 0, 0, loc), time.Date(2026, 1, 23, 0, 0, 0, 0, loc)},
+		{"overnight", time.Date(2026, 1, 25, 20, 0, 0, 0, loc), time.Date(2026, 1, 26, 4, 0, 0, 0, loc), time.Date(2026, 1, 26, 0, 0, 0, 0, loc)},
+		{"premarket", time.Date(2026, 1, 26, 4, 0, 0, 0, loc), time.Date(2026, 1, 26, 9, 30, 0, 0, loc), time.Date(2026, 1, 26, 0, 0, 0, 0, loc)},
+	}
+
+	if len(sessions) != len(want) {
+		t.Fatalf("Expected %d sessions, got %d: %v", len(want), len(sessions), sessions)
+	}
+	for i, w := range want {
+		s := sessions[i]
+		if s.Name != w.name || !s.Start.Equal(w.start) || !s.End.Equal(w.end) || !s.TradingDate.Equal(w.tradingDate) {
+			t.Errorf("Session %d: expected %s %v-%v (%v), got %s %v-%v (%v)",
+				i, w.name, w.start, w.end, w.tradingDate, s.Name, s.Start, s.End, s.TradingDate)
+		}
+		if s.Market != "NASDAQ" {
+			t.Errorf("Session %d: expected market NASDAQ, got %s", i, s.Market)
+		}
+	}
+}
+
+func TestChecker_SessionsHalfDay(t *testing.T) {
+	checker := NewChecker()
+
+	loc, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	// Lunar New Year's Eve 2026 is a half trading day followed by three holidays
+	sessions, err := checker.Sessions(MarketHKEX, time.Date(2026, 2, 16, 0, 0, 0, 0, loc), time.Date(2026, 2, 20, 0, 0, 0, 0, loc))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(sessions) != 1 || sessions[0].Name != "morning" || sessions[0].Status != StatusOpen {
+		t.Errorf("Expected a single morning session, got %v", sessions)
+	}
+
+	if _, err := checker.Sessions("UnknownMarket", time.Now(), time.Now().Add(time.Hour)); err == nil {
+		t.Error("Expected error for unknown market type")
+	}
+}
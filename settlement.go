@@ -0,0 +1,165 @@
+package marketchecker
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// SettlementConvention describes the settlement cycle of trades in a market
+type SettlementConvention struct {
+	SecuritiesLag int          // Securities settle on T+SecuritiesLag
+	CashLag       int          // Funds settle on T+CashLag
+	Calendars     []MarketType // Calendars that must all be open on counted days; empty means the market's own calendar
+}
+
+// defaultSettlementConventions returns the settlement conventions of the built-in markets
+func defaultSettlementConventions() map[MarketType]SettlementConvention {
+	chinaAShare := SettlementConvention{SecuritiesLag: 0, CashLag: 1}
+	northbound := SettlementConvention{
+		SecuritiesLag: 0,
+		CashLag:       1,
+		Calendars:     []MarketType{MarketHKEX, MarketChinaAShare},
+	}
+	southbound := SettlementConvention{
+		SecuritiesLag: 2,
+		CashLag:       2,
+		Calendars:     []MarketType{MarketHKEX, MarketChinaAShare},
+	}
+
+	return map[MarketType]SettlementConvention{
+		MarketNASDAQ:            {SecuritiesLag: 1, CashLag: 1},
+		MarketUSBonds:           {SecuritiesLag: 1, CashLag: 1},
+		MarketCboeEquityOptions: {SecuritiesLag: 1, CashLag: 1},
+		MarketCboeETFOptions:    {SecuritiesLag: 1, CashLag: 1},
+		MarketCboeIndexOptions:  {SecuritiesLag: 1, CashLag: 1},
+		MarketTSX:               {SecuritiesLag: 1, CashLag: 1},
+		MarketBMV:               {SecuritiesLag: 1, CashLag: 1},
+		MarketB3:                {SecuritiesLag: 2, CashLag: 2},
+		MarketHKEX:              {SecuritiesLag: 2, CashLag: 2},
+		MarketHKEXDerivatives:   {SecuritiesLag: 1, CashLag: 1},
+		MarketChinaAShare:       chinaAShare,
+		MarketSSE:               chinaAShare,
+		MarketSZSE:              chinaAShare,
+		MarketChinaMainBoard:    chinaAShare,
+		MarketChinaSTAR:         chinaAShare,
+		MarketChinaChiNext:      chinaAShare,
+		MarketChinaBSE:          chinaAShare,
+
+		MarketStockConnectNorthbound: northbound,
+		MarketStockConnectSouthbound: southbound,
+	}
+}
+
+// RegisterSettlementConvention sets the settlement convention used for the specified market
+func (c *Checker) RegisterSettlementConvention(marketType MarketType, convention SettlementConvention) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.settlements[marketType] = convention
+}
+
+// SettlementDate returns the securities settlement date of a trade executed on tradeDate in the specified market
+// The result is midnight of the settlement date in the market's timezone.
+func (c *Checker) SettlementDate(marketType MarketType, tradeDate time.Time) (time.Time, error) {
+	convention, calendar, err := c.settlementCalendar(marketType)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return settle(calendar, tradeDate, convention.SecuritiesLag)
+}
+
+// CashSettlementDate returns the funds settlement date of a trade executed on tradeDate in the specified market
+// The result is midnight of the settlement date in the market's timezone.
+func (c *Checker) CashSettlementDate(marketType MarketType, tradeDate time.Time) (time.Time, error) {
+	convention, calendar, err := c.settlementCalendar(marketType)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return settle(calendar, tradeDate, convention.CashLag)
+}
+
+// settlementCalendar returns the settlement convention of the specified market and the calendar it counts on
+func (c *Checker) settlementCalendar(marketType MarketType) (SettlementConvention, TradingCalendar, error) {
+	market, err := c.tradingCalendar(marketType)
+	if err != nil {
+		return SettlementConvention{}, nil, err
+	}
+
+	c.mu.RLock()
+	resolved, _ := c.resolve(marketType)
+	convention, ok := c.settlements[resolved]
+	c.mu.RUnlock()
+	if !ok {
+		return SettlementConvention{}, nil, fmt.Errorf("no settlement convention for market type %s: %w", marketType, errors.ErrUnsupported)
+	}
+	if len(convention.Calendars) == 0 {
+		return convention, market, nil
+	}
+
+	// Count in the trading market's timezone on days when all calendars are open
+	joint := jointCalendar{location: market.Location()}
+	if named, ok := market.(Market); ok {
+		joint.name = named.Name()
+	}
+	for _, calendarType := range convention.Calendars {
+		calendar, err := c.tradingCalendar(calendarType)
+		if err != nil {
+			return SettlementConvention{}, nil, err
+		}
+		joint.calendars = append(joint.calendars, calendar)
+	}
+	return convention, joint, nil
+}
+
+// settle returns the date lag trading days after tradeDate, which must be a trading day
+func settle(calendar TradingCalendar, tradeDate time.Time, lag int) (time.Time, error) {
+	if err := checkCoverage(calendar, tradeDate); err != nil {
+		return time.Time{}, err
+	}
+	if !calendar.IsTradingDay(tradeDate) {
+		return time.Time{}, fmt.Errorf("trade date %s is not a trading day", startOfDay(tradeDate, calendar.Location()).Format("2006-01-02"))
+	}
+	return addTradingDays(calendar, tradeDate, lag)
+}
+
+// jointCalendar is a trading calendar whose trading days are open in all underlying calendars
+type jointCalendar struct {
+	name      string
+	location  *time.Location
+	calendars []TradingCalendar
+}
+
+// IsTradingDay checks if the given date is a trading day in all calendars
+func (j jointCalendar) IsTradingDay(t time.Time) bool {
+	// Evaluate the calendar date in the joint calendar's timezone
+	day := startOfDay(t, j.location)
+	for _, calendar := range j.calendars {
+		local := time.Date(day.Year(), day.Month(), day.Day(), 12, 0, 0, 0, calendar.Location())
+		if !calendar.IsTradingDay(local) {
+			return false
+		}
+	}
+	return true
+}
+
+// Name returns the name of the market the joint calendar settles
+func (j jointCalendar) Name() string {
+	return j.name
+}
+
+// Covers checks if the holiday data of every underlying calendar covers the given date
+func (j jointCalendar) Covers(t time.Time) bool {
+	day := startOfDay(t, j.location)
+	for _, calendar := range j.calendars {
+		local := time.Date(day.Year(), day.Month(), day.Day(), 12, 0, 0, 0, calendar.Location())
+		if checkCoverage(calendar, local) != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// Location returns the timezone the joint calendar's dates are expressed in
+func (j jointCalendar) Location() *time.Location {
+	return j.location
+}
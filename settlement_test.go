@@ -0,0 +1,126 @@
+package marketchecker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestChecker_SettlementDate(t *testing.T) {
+	checker := NewChecker()
+
+	nyLoc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+	hkLoc, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+	cnLoc, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	tests := []struct {
+		desc      string
+		market    MarketType
+		tradeDate time.Time
+		want      time.Time
+	}{
+		{"US T+1 over MLK weekend", MarketNASDAQ, time.Date(2026, 1, 16, 0, 0, 0, 0, nyLoc), time.Date(2026, 1, 20, 0, 0, 0, 0, nyLoc)},
+		{"HKEX T+2 over Lunar New Year", MarketHKEX, time.Date(2026, 2, 13, 0, 0, 0, 0, hkLoc), time.Date(2026, 2, 20, 0, 0, 0, 0, hkLoc)},
+		{"China A-Share securities on T+0", MarketChinaAShare, time.Date(2026, 2, 13, 0, 0, 0, 0, cnLoc), time.Date(2026, 2, 13, 0, 0, 0, 0, cnLoc)},
+		{"Northbound securities on T+0", MarketStockConnectNorthbound, time.Date(2026, 2, 13, 0, 0, 0, 0, cnLoc), time.Date(2026, 2, 13, 0, 0, 0, 0, cnLoc)},
+		{"Southbound T+2 on both calendars", MarketStockConnectSouthbound, time.Date(2026, 2, 11, 0, 0, 0, 0, hkLoc), time.Date(2026, 2, 13, 0, 0, 0, 0, hkLoc)},
+		{"Southbound T+2 skips mainland holiday", MarketStockConnectSouthbound, time.Date(2026, 2, 12, 0, 0, 0, 0, hkLoc), time.Date(2026, 2, 23, 0, 0, 0, 0, hkLoc)},
+	}
+
+	for _, tt := range tests {
+		got, err := checker.SettlementDate(tt.market, tt.tradeDate)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.desc, err)
+		}
+		if !got.Equal(tt.want) {
+			t.Errorf("%s: expected settlement date %v, got %v", tt.desc, tt.want, got)
+		}
+	}
+}
+
+func TestChecker_CashSettlementDate(t *testing.T) {
+	checker := NewChecker()
+
+	loc, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+	tradeDate := time.Date(2026, 2, 13, 10, 0, 0, 0, loc)
+
+	// China A-Share and Northbound cash settles on T+1, here over the Spring Festival
+	for _, market := range []MarketType{MarketChinaAShare, MarketSSE, MarketChinaSTAR, MarketStockConnectNorthbound} {
+		got, err := checker.CashSettlementDate(market, tradeDate)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", market, err)
+		}
+		if want := time.Date(2026, 2, 23, 0, 0, 0, 0, loc); !got.Equal(want) {
+			t.Errorf("%s: expected cash settlement date %v, got %v", market, want, got)
+		}
+	}
+}
+
+func TestChecker_RegisterSettlementConvention(t *testing.T) {
+	checker := NewChecker()
+
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	checker.RegisterSettlementConvention(MarketNASDAQ, SettlementConvention{SecuritiesLag: 2, CashLag: 2})
+	got, err := checker.SettlementDate(MarketNASDAQ, time.Date(2026, 1, 16, 0, 0, 0, 0, loc))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if want := time.Date(2026, 1, 21, 0, 0, 0, 0, loc); !got.Equal(want) {
+		t.Errorf("Expected settlement date %v, got %v", want, got)
+	}
+
+	// Custom markets can settle on several calendars
+	checker.AddMarket("CrossListed", NewHKEX())
+	checker.RegisterSettlementConvention("CrossListed", SettlementConvention{
+		SecuritiesLag: 1,
+		CashLag:       1,
+		Calendars:     []MarketType{MarketHKEX, MarketNASDAQ},
+	})
+	hkLoc, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+	got, err = checker.SettlementDate("CrossListed", time.Date(2026, 1, 16, 0, 0, 0, 0, hkLoc))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if want := time.Date(2026, 1, 20, 0, 0, 0, 0, hkLoc); !got.Equal(want) {
+		t.Errorf("Expected settlement date %v skipping MLK Day, got %v", want, got)
+	}
+}
+
+func TestChecker_SettlementDateErrors(t *testing.T) {
+	checker := NewChecker()
+
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	if _, err := checker.SettlementDate(MarketNASDAQ, time.Date(2026, 1, 17, 0, 0, 0, 0, loc)); err == nil {
+		t.Error("Expected error for a trade date on a weekend")
+	}
+
+	if _, err := checker.SettlementDate(MarketSHFEPreciousMetals, time.Date(2026, 1, 20, 0, 0, 0, 0, loc)); err == nil {
+		t.Error("Expected error for a market without a settlement convention")
+	}
+
+	if _, err := checker.SettlementDate("UnknownMarket", time.Now()); err == nil {
+		t.Error("Expected error for unknown market type")
+	}
+}
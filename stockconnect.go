@@ -0,0 +1,132 @@
+package marketchecker
+
+import (
+	"time"
+)
+
+// ConnectDirection identifies the trading link of Shanghai/Shenzhen-Hong Kong Stock Connect
+type ConnectDirection string
+
+const (
+	// ConnectNorthbound is trading of mainland securities by Hong Kong and international investors
+	ConnectNorthbound ConnectDirection = "northbound"
+	// ConnectSouthbound is trading of Hong Kong securities by mainland investors
+	ConnectSouthbound ConnectDirection = "southbound"
+)
+
+// StockConnect represents one direction of Stock Connect
+//
+// Stock Connect is only available on days when both HKEX and the mainland
+// exchanges are open for trading and banks in both markets are open on the
+// corresponding money settlement day (T+1 for Northbound, T+2 for Southbound).
+// During a Stock Connect trading day the trading hours are those of the
+// market where the securities are listed.
+//
+// The closures that follow from these rules are derived from the HKEX and
+// China A-Share holiday calendars. On top of them Southbound does not open on
+// Hong Kong half trading days; these exception days are built in for 2025 and
+// 2026. Further Connect-specific closures published by HKEX can be passed to
+// the constructors.
+type StockConnect struct {
+	direction  ConnectDirection
+	hkex       *HKEX
+	china      *ChinaAShare
+	exceptions HolidayProvider
+}
+
+// NewStockConnectNorthbound creates a new Northbound Stock Connect market instance
+// The optional exceptions are additional Connect-specific closure days published by HKEX.
+func NewStockConnectNorthbound(exceptions ...time.Time) *StockConnect {
+	return newStockConnect(ConnectNorthbound, exceptions)
+}
+
+// NewStockConnectSouthbound creates a new Southbound Stock Connect market instance
+// The optional exceptions are closure days published by HKEX in addition to the built-in ones.
+func NewStockConnectSouthbound(exceptions ...time.Time) *StockConnect {
+	return newStockConnect(ConnectSouthbound, append(append([]time.Time(nil), southboundConnectExceptions...), exceptions...))
+}
+
+func newStockConnect(direction ConnectDirection, exceptions []time.Time) *StockConnect {
+	return &StockConnect{
+		direction:  direction,
+		hkex:       NewHKEX(),
+		china:      NewChinaAShare(),
+		exceptions: NewStaticHolidayProvider(exceptions),
+	}
+}
+
+// Name returns the market name
+func (s *StockConnect) Name() string {
+	if s.direction == ConnectSouthbound {
+		return "Stock Connect Southbound"
+	}
+	return "Stock Connect Northbound"
+}
+
+// Location returns the market timezone
+func (s *StockConnect) Location() *time.Location {
+	return chinaLocation
+}
+
+// Direction returns the Stock Connect trading link
+func (s *StockConnect) Direction() ConnectDirection {
+	return s.direction
+}
+
+// IsOpen checks if Stock Connect is open for trading at the given time
+func (s *StockConnect) IsOpen(t time.Time) bool {
+	status := s.GetStatus(t)
+	return status == StatusOpen
+}
+
+// GetStatus returns the current market status at the given time
+func (s *StockConnect) GetStatus(t time.Time) MarketStatus {
+	return statusAt(s, t)
+}
+
+// SessionsOn returns the Stock Connect trading sessions belonging to the given trading date
+// Trading hours follow the market where the securities are listed.
+func (s *StockConnect) SessionsOn(date time.Time) []Session {
+	if !s.IsTradingDay(date) {
+		return nil
+	}
+
+	var sessions []Session
+	if s.direction == ConnectSouthbound {
+		sessions = s.hkex.SessionsOn(date)
+	} else {
+		sessions = s.china.SessionsOn(date)
+	}
+	for i := range sessions {
+		sessions[i].Market = s.Name()
+	}
+	return sessions
+}
+
+// IsTradingDay checks if the given date is a Stock Connect trading day
+func (s *StockConnect) IsTradingDay(t time.Time) bool {
+	// HKEX and the mainland exchanges share the same UTC+8 calendar date
+	localTime := t.In(chinaLocation)
+
+	if s.exceptions.IsHoliday(localTime) {
+		return false
+	}
+
+	if !s.hkex.IsTradingDay(localTime) || !s.china.IsTradingDay(localTime) {
+		return false
+	}
+
+	// Money settlement happens on the listing market's calendar and needs
+	// banks on both sides to be open
+	if s.direction == ConnectSouthbound {
+		settlement := nextTradingDays(s.hkex.IsTradingDay, localTime, 2)
+		return s.china.IsTradingDay(settlement)
+	}
+	settlement := nextTradingDays(s.china.IsTradingDay, localTime, 1)
+	return s.hkex.IsTradingDay(settlement)
+}
+
+// Covers checks if the holiday data of both HKEX and the mainland exchanges covers the given date
+func (s *StockConnect) Covers(t time.Time) bool {
+	return s.hkex.Covers(t) && s.china.Covers(t)
+}
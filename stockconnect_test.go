@@ -0,0 +1,160 @@
+package marketchecker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStockConnect_TradingHoursFollowListingMarket(t *testing.T) {
+	northbound := NewStockConnectNorthbound()
+	southbound := NewStockConnectSouthbound()
+
+	loc, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	// Wednesday 3:30 PM: mainland market has closed, HKEX is still trading
+	afternoon := time.Date(2026, 2, 11, 15, 30, 0, 0, loc)
+	if northbound.IsOpen(afternoon) {
+		t.Errorf("Northbound should be closed after the mainland close at %v", afternoon)
+	}
+	if !southbound.IsOpen(afternoon) {
+		t.Errorf("Southbound should be open during HKEX hours at %v", afternoon)
+	}
+
+	// Wednesday 12:30 PM: HKEX lunch break
+	lunch := time.Date(2026, 2, 11, 12, 30, 0, 0, loc)
+	if southbound.GetStatus(lunch) != StatusClosed {
+		t.Errorf("Southbound should be closed during the HKEX lunch break at %v", lunch)
+	}
+}
+
+func TestStockConnect_SettlementAroundLunarNewYear(t *testing.T) {
+	northbound := NewStockConnectNorthbound()
+	southbound := NewStockConnectSouthbound()
+
+	loc, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	tests := []struct {
+		desc           string
+		time           time.Time
+		wantNorthbound bool
+		wantSouthbound bool
+	}{
+		{"Feb 11: ordinary trading day", time.Date(2026, 2, 11, 10, 0, 0, 0, loc), true, true},
+		{"Feb 12: Southbound T+2 falls on mainland holiday", time.Date(2026, 2, 12, 10, 0, 0, 0, loc), true, false},
+		{"Feb 13: Southbound T+2 falls on mainland holiday", time.Date(2026, 2, 13, 10, 0, 0, 0, loc), true, false},
+		{"Feb 16: mainland holiday, HKEX open", time.Date(2026, 2, 16, 10, 0, 0, 0, loc), false, false},
+		{"Feb 20: mainland holiday, HKEX open", time.Date(2026, 2, 20, 10, 0, 0, 0, loc), false, false},
+		{"Feb 23: both markets reopen", time.Date(2026, 2, 23, 10, 0, 0, 0, loc), true, true},
+	}
+
+	for _, tt := range tests {
+		if got := northbound.IsOpen(tt.time); got != tt.wantNorthbound {
+			t.Errorf("%s: Northbound open = %v, want %v", tt.desc, got, tt.wantNorthbound)
+		}
+		if got := southbound.IsOpen(tt.time); got != tt.wantSouthbound {
+			t.Errorf("%s: Southbound open = %v, want %v", tt.desc, got, tt.wantSouthbound)
+		}
+	}
+}
+
+func TestStockConnect_NorthboundClosedBeforeHKHoliday(t *testing.T) {
+	northbound := NewStockConnectNorthbound()
+
+	loc, err := time.LoadLocation("Asia/Shanghai")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	// Dec 24, 2026: T+1 money settlement falls on Christmas, a Hong Kong holiday
+	christmasEve := time.Date(2026, 12, 24, 10, 0, 0, 0, loc)
+	if northbound.IsOpen(christmasEve) {
+		t.Errorf("Northbound should be closed at %v", christmasEve)
+	}
+
+	// Dec 25, 2026: mainland open, Hong Kong closed
+	christmas := time.Date(2026, 12, 25, 10, 0, 0, 0, loc)
+	if northbound.IsOpen(christmas) {
+		t.Errorf("Northbound should be closed on a Hong Kong holiday at %v", christmas)
+	}
+}
+
+func TestStockConnect_Exceptions(t *testing.T) {
+	loc, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+	closure := time.Date(2026, 3, 10, 0, 0, 0, 0, loc)
+
+	southbound := NewStockConnectSouthbound(closure)
+	if southbound.IsOpen(time.Date(2026, 3, 10, 10, 0, 0, 0, loc)) {
+		t.Error("Southbound should be closed on a published exception day")
+	}
+	if !southbound.IsOpen(time.Date(2026, 3, 11, 10, 0, 0, 0, loc)) {
+		t.Error("Southbound should be open on the day after a published exception day")
+	}
+}
+
+func TestChecker_StockConnectPublishedExceptions(t *testing.T) {
+	loc, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+	closure := time.Date(2026, 3, 10, 0, 0, 0, 0, loc)
+	during := time.Date(2026, 3, 10, 10, 0, 0, 0, loc)
+
+	// The built-in market only knows the closures derived from both calendars
+	checker := NewChecker()
+	if open, err := checker.IsOpen(MarketStockConnectNorthbound, during); err != nil || !open {
+		t.Fatalf("Expected Northbound to be open without published exceptions, got %v (%v)", open, err)
+	}
+
+	if err := checker.ReplaceMarket(MarketStockConnectNorthbound, NewStockConnectNorthbound(closure)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if open, err := checker.IsOpen(MarketStockConnectNorthbound, during); err != nil || open {
+		t.Errorf("Expected Northbound to be closed on a published exception day, got %v (%v)", open, err)
+	}
+	if trading, err := checker.IsTradingDay(MarketStockConnectNorthbound, closure); err != nil || trading {
+		t.Errorf("Expected the published exception day not to be a trading day, got %v (%v)", trading, err)
+	}
+}
+
+func TestChecker_StockConnectHalfDayExceptions(t *testing.T) {
+	loc, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	// New Year's Eve 2025: both markets trade and the settlement rules allow
+	// Connect trading, but Hong Kong only trades a half day
+	newYearsEve := time.Date(2025, 12, 31, 10, 0, 0, 0, loc)
+
+	checker := NewChecker()
+	if open, err := checker.IsOpen(MarketStockConnectSouthbound, newYearsEve); err != nil || open {
+		t.Errorf("Expected Southbound to be closed on a Hong Kong half day, got %v (%v)", open, err)
+	}
+	if open, err := checker.IsOpen(MarketStockConnectNorthbound, newYearsEve); err != nil || !open {
+		t.Errorf("Expected Northbound to be open on a Hong Kong half day, got %v (%v)", open, err)
+	}
+
+	// Additional exception days keep the built-in ones
+	southbound := NewStockConnectSouthbound(time.Date(2026, 3, 10, 0, 0, 0, 0, loc))
+	if southbound.IsOpen(newYearsEve) {
+		t.Errorf("Expected Southbound to stay closed on %v", newYearsEve)
+	}
+}
+
+func TestStockConnect_Name(t *testing.T) {
+	if name := NewStockConnectNorthbound().Name(); name != "Stock Connect Northbound" {
+		t.Errorf("Expected name 'Stock Connect Northbound', got '%s'", name)
+	}
+	if name := NewStockConnectSouthbound().Name(); name != "Stock Connect Southbound" {
+		t.Errorf("Expected name 'Stock Connect Southbound', got '%s'", name)
+	}
+}
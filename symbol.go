@@ -0,0 +1,246 @@
+package marketchecker
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// SymbolRule maps an instrument symbol to a market type
+// The symbol is trimmed and upper-cased before rules see it. Rules return false for symbols they do not recognize.
+type SymbolRule func(symbol string) (MarketType, bool)
+
+// SymbolResolver maps instrument symbols to market types by trying its rules in order
+// A SymbolResolver is safe for concurrent use.
+type SymbolResolver struct {
+	mu    sync.RWMutex
+	rules []SymbolRule
+}
+
+// NewSymbolResolver creates a symbol resolver with the given rules
+// Use DefaultSymbolRules for the built-in exchange suffix and Bloomberg conventions.
+func NewSymbolResolver(rules ...SymbolRule) *SymbolResolver {
+	return &SymbolResolver{rules: append([]SymbolRule(nil), rules...)}
+}
+
+// AddRule adds a rule that takes precedence over the existing rules
+func (r *SymbolResolver) AddRule(rule SymbolRule) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.rules = append([]SymbolRule{rule}, r.rules...)
+}
+
+// Resolve returns the market type of the symbol
+// Returns an UnknownSymbolError if no rule recognizes the symbol.
+func (r *SymbolResolver) Resolve(symbol string) (MarketType, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(symbol))
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, rule := range r.rules {
+		if market, ok := rule(normalized); ok {
+			return market, nil
+		}
+	}
+	return "", &UnknownSymbolError{Symbol: symbol}
+}
+
+// DefaultSymbolRules returns the built-in symbol rules
+// They recognize, in order of precedence:
+//   - mainland codes with an exchange suffix ("600519.SS", "000001.SZ") or a Bloomberg exchange code
+//     ("600519 CH Equity"), mapped to the STAR Market or ChiNext board where the code range says so
+//   - exchange suffixes of other markets ("0700.HK", "RY.TO", "PETR4.SA", "WALMEX.MX", "AAPL.O")
+//   - Bloomberg tickers with the Equity yellow key ("700 HK Equity", "AAPL UW Equity")
+//   - plain US tickers of up to five letters ("AAPL"), mapped to NASDAQ
+func DefaultSymbolRules() []SymbolRule {
+	return []SymbolRule{
+		chinaSymbolRule,
+		SuffixRule(map[string]MarketType{
+			"HK": MarketHKEX,
+			"TO": MarketTSX,
+			"SA": MarketB3,
+			"MX": MarketBMV,
+			"O":  MarketNASDAQ,
+			"OQ": MarketNASDAQ,
+		}),
+		BloombergRule(map[string]MarketType{
+			"HK": MarketHKEX,
+			"US": MarketNASDAQ,
+			"UW": MarketNASDAQ,
+			"UQ": MarketNASDAQ,
+			"UR": MarketNASDAQ,
+			"CN": MarketTSX,
+			"CT": MarketTSX,
+			"BZ": MarketB3,
+			"BS": MarketB3,
+			"MM": MarketBMV,
+		}),
+		USTickerRule(MarketNASDAQ),
+	}
+}
+
+// SuffixRule maps symbols of the form "<code>.<suffix>" by their exchange suffix, e.g. "HK" for "0700.HK"
+// Suffixes are matched case-insensitively.
+func SuffixRule(suffixes map[string]MarketType) SymbolRule {
+	normalized := make(map[string]MarketType, len(suffixes))
+	for suffix, market := range suffixes {
+		normalized[strings.ToUpper(suffix)] = market
+	}
+	return func(symbol string) (MarketType, bool) {
+		code, suffix, ok := splitSuffix(symbol)
+		if !ok || code == "" {
+			return "", false
+		}
+		market, ok := normalized[suffix]
+		return market, ok
+	}
+}
+
+// BloombergRule maps Bloomberg tickers of the form "<code> <exchange code> Equity" by their exchange code
+// Exchange codes are matched case-insensitively.
+func BloombergRule(exchangeCodes map[string]MarketType) SymbolRule {
+	normalized := make(map[string]MarketType, len(exchangeCodes))
+	for code, market := range exchangeCodes {
+		normalized[strings.ToUpper(code)] = market
+	}
+	return func(symbol string) (MarketType, bool) {
+		_, exchangeCode, ok := splitBloomberg(symbol)
+		if !ok {
+			return "", false
+		}
+		market, ok := normalized[exchangeCode]
+		return market, ok
+	}
+}
+
+// USTickerRule maps plain tickers of one to five letters, e.g. "AAPL", to the given market
+func USTickerRule(market MarketType) SymbolRule {
+	return func(symbol string) (MarketType, bool) {
+		if len(symbol) == 0 || len(symbol) > 5 {
+			return "", false
+		}
+		for _, r := range symbol {
+			if r < 'A' || r > 'Z' {
+				return "", false
+			}
+		}
+		return market, true
+	}
+}
+
+// chinaSymbolRule maps six-digit mainland codes with an exchange suffix or a Bloomberg exchange code
+func chinaSymbolRule(symbol string) (MarketType, bool) {
+	code, exchange, ok := splitSuffix(symbol)
+	if !ok {
+		if code, exchange, ok = splitBloomberg(symbol); !ok {
+			return "", false
+		}
+	}
+	if !isDigits(code, 6) {
+		return "", false
+	}
+
+	switch exchange {
+	case "SS", "SH", "CG", "C1":
+		return chinaBoardMarket(code, MarketSSE), true
+	case "SZ", "CS", "C2":
+		return chinaBoardMarket(code, MarketSZSE), true
+	case "BJ":
+		return MarketChinaBSE, true
+	case "CH":
+		// The Bloomberg composite code is resolved from the code range
+		switch {
+		case code[0] == '6':
+			return chinaBoardMarket(code, MarketSSE), true
+		case code[0] == '0' || code[0] == '3':
+			return chinaBoardMarket(code, MarketSZSE), true
+		case code[0] == '4' || code[0] == '8' || strings.HasPrefix(code, "920"):
+			return MarketChinaBSE, true
+		case strings.HasPrefix(code, "900"):
+			// SSE B-shares
+			return MarketSSE, true
+		case strings.HasPrefix(code, "200"):
+			// SZSE B-shares
+			return MarketSZSE, true
+		}
+	}
+	return "", false
+}
+
+// chinaBoardMarket returns the board market of a mainland code listed on the given exchange
+// STAR Market (688, 689) and ChiNext (300, 301) codes have an after-hours session the main boards lack.
+func chinaBoardMarket(code string, exchange MarketType) MarketType {
+	switch {
+	case exchange == MarketSSE && (strings.HasPrefix(code, "688") || strings.HasPrefix(code, "689")):
+		return MarketChinaSTAR
+	case exchange == MarketSZSE && (strings.HasPrefix(code, "300") || strings.HasPrefix(code, "301")):
+		return MarketChinaChiNext
+	}
+	return exchange
+}
+
+// splitSuffix splits "<code>.<suffix>" at the last dot
+func splitSuffix(symbol string) (string, string, bool) {
+	i := strings.LastIndexByte(symbol, '.')
+	if i < 0 || i == len(symbol)-1 {
+		return "", "", false
+	}
+	return symbol[:i], symbol[i+1:], true
+}
+
+// splitBloomberg splits "<code> <exchange code> EQUITY" into its code and exchange code
+func splitBloomberg(symbol string) (string, string, bool) {
+	fields := strings.Fields(symbol)
+	if len(fields) != 3 || fields[2] != "EQUITY" {
+		return "", "", false
+	}
+	return fields[0], fields[1], true
+}
+
+// isDigits checks if s consists of exactly n ASCII digits
+func isDigits(s string, n int) bool {
+	if len(s) != n {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// WithSymbolResolver sets the resolver the checker uses to map symbols to markets
+func WithSymbolResolver(resolver *SymbolResolver) Option {
+	return func(c *Checker) {
+		c.symbols = resolver
+	}
+}
+
+// SymbolResolver returns the resolver the checker uses to map symbols to markets
+func (c *Checker) SymbolResolver() *SymbolResolver {
+	return c.symbols
+}
+
+// ResolveSymbol returns the market type the symbol trades on
+func (c *Checker) ResolveSymbol(symbol string) (MarketType, error) {
+	return c.symbols.Resolve(symbol)
+}
+
+// IsOpenForSymbol checks if the market the symbol trades on is open at the given time
+func (c *Checker) IsOpenForSymbol(symbol string, t time.Time) (bool, error) {
+	marketType, err := c.symbols.Resolve(symbol)
+	if err != nil {
+		return false, err
+	}
+	return c.IsOpen(marketType, t)
+}
+
+// GetStatusForSymbol returns the status of the market the symbol trades on at the given time
+func (c *Checker) GetStatusForSymbol(symbol string, t time.Time) (MarketStatus, error) {
+	marketType, err := c.symbols.Resolve(symbol)
+	if err != nil {
+		return "", err
+	}
+	return c.GetStatus(marketType, t)
+}
@@ -0,0 +1,129 @@
+package marketchecker
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSymbolResolver_DefaultRules(t *testing.T) {
+	resolver := NewSymbolResolver(DefaultSymbolRules()...)
+
+	tests := []struct {
+		symbol string
+		want   MarketType
+	}{
+		{"0700.HK", MarketHKEX},
+		{"0700.hk", MarketHKEX},
+		{"600519.SS", MarketSSE},
+		{"600519.SH", MarketSSE},
+		{"688981.SS", MarketChinaSTAR},
+		{"000001.SZ", MarketSZSE},
+		{"300750.SZ", MarketChinaChiNext},
+		{"430047.BJ", MarketChinaBSE},
+		{"AAPL", MarketNASDAQ},
+		{" aapl ", MarketNASDAQ},
+		{"AAPL.O", MarketNASDAQ},
+		{"RY.TO", MarketTSX},
+		{"PETR4.SA", MarketB3},
+		{"WALMEX.MX", MarketBMV},
+		{"700 HK Equity", MarketHKEX},
+		{"AAPL UW Equity", MarketNASDAQ},
+		{"600519 CH Equity", MarketSSE},
+		{"300750 CH Equity", MarketChinaChiNext},
+		{"000001 CS Equity", MarketSZSE},
+		{"920118 CH Equity", MarketChinaBSE},
+		{"430047 CH Equity", MarketChinaBSE},
+		{"900901 CH Equity", MarketSSE},
+		{"200002 CH Equity", MarketSZSE},
+		{"RY CT Equity", MarketTSX},
+	}
+
+	for _, tt := range tests {
+		got, err := resolver.Resolve(tt.symbol)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", tt.symbol, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%q: expected %s, got %s", tt.symbol, tt.want, got)
+		}
+	}
+
+	for _, symbol := range []string{"", "TOOLONG", "0700.XX", "700 HK Comdty", "123456.HK.XX", "999999 CH Equity"} {
+		_, err := resolver.Resolve(symbol)
+		if !errors.Is(err, ErrUnknownSymbol) {
+			t.Errorf("%q: expected ErrUnknownSymbol, got %v", symbol, err)
+		}
+		var unknown *UnknownSymbolError
+		if !errors.As(err, &unknown) || unknown.Symbol != symbol {
+			t.Errorf("%q: expected UnknownSymbolError, got %v", symbol, err)
+		}
+	}
+}
+
+func TestSymbolResolver_AddRule(t *testing.T) {
+	resolver := NewSymbolResolver(DefaultSymbolRules()...)
+
+	// Custom rules take precedence, e.g. to route HK-listed symbols to derivatives hours
+	resolver.AddRule(SuffixRule(map[string]MarketType{"hkf": MarketHKEXDerivatives, "hk": "CUSTOM"}))
+
+	if got, err := resolver.Resolve("HSI.HKF"); err != nil || got != MarketHKEXDerivatives {
+		t.Errorf("Expected HKEXDerivatives, got %s (%v)", got, err)
+	}
+	if got, err := resolver.Resolve("0700.HK"); err != nil || got != "CUSTOM" {
+		t.Errorf("Expected the custom rule to win, got %s (%v)", got, err)
+	}
+	if got, err := resolver.Resolve("AAPL"); err != nil || got != MarketNASDAQ {
+		t.Errorf("Expected default rules to still apply, got %s (%v)", got, err)
+	}
+}
+
+func TestChecker_IsOpenForSymbol(t *testing.T) {
+	checker := NewChecker()
+
+	hk, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	// 15:10 HKT: HKEX open, SSE closed, STAR Market in its after-hours session
+	now := time.Date(2026, 1, 26, 15, 10, 0, 0, hk)
+
+	tests := []struct {
+		symbol     string
+		wantOpen   bool
+		wantStatus MarketStatus
+	}{
+		{"0700.HK", true, StatusOpen},
+		{"600519.SS", false, StatusClosed},
+		{"688981.SS", false, StatusAfterHours},
+	}
+
+	for _, tt := range tests {
+		open, err := checker.IsOpenForSymbol(tt.symbol, now)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.symbol, err)
+		}
+		status, err := checker.GetStatusForSymbol(tt.symbol, now)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.symbol, err)
+		}
+		if open != tt.wantOpen || status != tt.wantStatus {
+			t.Errorf("%s: expected open=%v status=%s, got open=%v status=%s", tt.symbol, tt.wantOpen, tt.wantStatus, open, status)
+		}
+	}
+
+	if _, err := checker.IsOpenForSymbol("???", now); !errors.Is(err, ErrUnknownSymbol) {
+		t.Errorf("Expected ErrUnknownSymbol, got %v", err)
+	}
+
+	// A custom resolver can be injected
+	custom := NewSymbolResolver(func(symbol string) (MarketType, bool) {
+		return MarketHKEX, symbol == "TENCENT"
+	})
+	checker = NewChecker(WithSymbolResolver(custom))
+	if market, err := checker.ResolveSymbol("tencent"); err != nil || market != MarketHKEX {
+		t.Errorf("Expected HKEX from custom resolver, got %s (%v)", market, err)
+	}
+}
@@ -0,0 +1,105 @@
+package marketchecker
+
+import (
+	"fmt"
+	"time"
+)
+
+// TimeOfDay is a wall-clock time of day, independent of any date or timezone
+// Session boundaries are defined as times of day so that they keep their
+// local meaning on days when daylight saving time starts or ends.
+type TimeOfDay struct {
+	Hour   int // 0-23
+	Minute int // 0-59
+	Second int // 0-59
+}
+
+// ParseTimeOfDay parses a time of day in "15:04" or "15:04:05" form
+func ParseTimeOfDay(s string) (TimeOfDay, error) {
+	for _, layout := range []string{"15:04", "15:04:05"} {
+		if len(s) != len(layout) {
+			continue
+		}
+		t, err := time.Parse(layout, s)
+		if err != nil {
+			break
+		}
+		return TimeOfDayOf(t), nil
+	}
+	return TimeOfDay{}, fmt.Errorf("invalid time of day %q: expected HH:MM or HH:MM:SS", s)
+}
+
+// TimeOfDayOf returns the wall-clock time of day of t in its location
+// Fractions of a second are truncated.
+func TimeOfDayOf(t time.Time) TimeOfDay {
+	hour, minute, second := t.Clock()
+	return TimeOfDay{Hour: hour, Minute: minute, Second: second}
+}
+
+// IsValid checks if the fields are within their ranges
+func (d TimeOfDay) IsValid() bool {
+	return d.Hour >= 0 && d.Hour < 24 &&
+		d.Minute >= 0 && d.Minute < 60 &&
+		d.Second >= 0 && d.Second < 60
+}
+
+// String returns the time of day as "15:04", or "15:04:05" if it has seconds
+func (d TimeOfDay) String() string {
+	if d.Second != 0 {
+		return fmt.Sprintf("%02d:%02d:%02d", d.Hour, d.Minute, d.Second)
+	}
+	return fmt.Sprintf("%02d:%02d", d.Hour, d.Minute)
+}
+
+// SinceMidnight returns the wall-clock offset of the time of day from midnight
+func (d TimeOfDay) SinceMidnight() time.Duration {
+	return time.Duration(d.Hour)*time.Hour + time.Duration(d.Minute)*time.Minute + time.Duration(d.Second)*time.Second
+}
+
+// Compare returns -1 if d is before other, +1 if it is after and 0 if they are equal
+func (d TimeOfDay) Compare(other TimeOfDay) int {
+	a, b := d.SinceMidnight(), other.SinceMidnight()
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// Before checks if d is earlier in the day than other
+func (d TimeOfDay) Before(other TimeOfDay) bool {
+	return d.Compare(other) < 0
+}
+
+// After checks if d is later in the day than other
+func (d TimeOfDay) After(other TimeOfDay) bool {
+	return d.Compare(other) > 0
+}
+
+// On returns the absolute time of the time of day on the calendar day of date in the given location
+// The calendar day is read from date as-is; convert it to the location first
+// if it was recorded elsewhere. Times skipped by a DST change are normalized
+// the way time.Date does, e.g. 02:30 on a spring-forward day becomes 03:30.
+func (d TimeOfDay) On(date time.Time, loc *time.Location) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), d.Hour, d.Minute, d.Second, 0, loc)
+}
+
+// MarshalText encodes the time of day in "15:04" or "15:04:05" form
+func (d TimeOfDay) MarshalText() ([]byte, error) {
+	if !d.IsValid() {
+		return nil, fmt.Errorf("invalid time of day %02d:%02d:%02d", d.Hour, d.Minute, d.Second)
+	}
+	return []byte(d.String()), nil
+}
+
+// UnmarshalText decodes a time of day in "15:04" or "15:04:05" form
+func (d *TimeOfDay) UnmarshalText(text []byte) error {
+	parsed, err := ParseTimeOfDay(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
@@ -0,0 +1,144 @@
+package marketchecker
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestParseTimeOfDay(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    TimeOfDay
+		wantErr bool
+	}{
+		{"09:30", TimeOfDay{Hour: 9, Minute: 30}, false},
+		{"00:00", TimeOfDay{}, false},
+		{"23:59:59", TimeOfDay{Hour: 23, Minute: 59, Second: 59}, false},
+		{"16:15:00", TimeOfDay{Hour: 16, Minute: 15}, false},
+		{"9:30", TimeOfDay{}, true},
+		{"24:00", TimeOfDay{}, true},
+		{"12:60", TimeOfDay{}, true},
+		{"12:00:60", TimeOfDay{}, true},
+		{"noon", TimeOfDay{}, true},
+		{"", TimeOfDay{}, true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseTimeOfDay(tt.input)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ParseTimeOfDay(%q): expected error, got %v", tt.input, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseTimeOfDay(%q): unexpected error: %v", tt.input, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseTimeOfDay(%q): expected %v, got %v", tt.input, tt.want, got)
+		}
+	}
+}
+
+func TestTimeOfDay_String(t *testing.T) {
+	tests := []struct {
+		tod  TimeOfDay
+		want string
+	}{
+		{TimeOfDay{Hour: 9, Minute: 30}, "09:30"},
+		{TimeOfDay{}, "00:00"},
+		{TimeOfDay{Hour: 15, Minute: 4, Second: 5}, "15:04:05"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.tod.String(); got != tt.want {
+			t.Errorf("Expected %q, got %q", tt.want, got)
+		}
+	}
+}
+
+func TestTimeOfDay_Compare(t *testing.T) {
+	openAt := TimeOfDay{Hour: 9, Minute: 30}
+	closeAt := TimeOfDay{Hour: 16}
+
+	if openAt.Compare(closeAt) != -1 || closeAt.Compare(openAt) != 1 || openAt.Compare(openAt) != 0 {
+		t.Errorf("Unexpected Compare results for %v and %v", openAt, closeAt)
+	}
+	if !openAt.Before(closeAt) || openAt.After(closeAt) {
+		t.Errorf("Expected %v to be before %v", openAt, closeAt)
+	}
+	if !closeAt.After(openAt) || closeAt.Before(openAt) {
+		t.Errorf("Expected %v to be after %v", closeAt, openAt)
+	}
+	if (TimeOfDay{Hour: 9, Minute: 30, Second: 1}).Compare(openAt) != 1 {
+		t.Error("Expected seconds to be compared")
+	}
+}
+
+func TestTimeOfDay_On(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	open := TimeOfDay{Hour: 9, Minute: 30}
+
+	tests := []struct {
+		desc string
+		date time.Time
+		want time.Time
+	}{
+		{"standard time", time.Date(2026, 3, 6, 0, 0, 0, 0, loc), time.Date(2026, 3, 6, 14, 30, 0, 0, time.UTC)},
+		{"daylight time", time.Date(2026, 3, 9, 0, 0, 0, 0, loc), time.Date(2026, 3, 9, 13, 30, 0, 0, time.UTC)},
+		{"date from another zone keeps its calendar day", time.Date(2026, 3, 9, 23, 0, 0, 0, time.UTC), time.Date(2026, 3, 9, 13, 30, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		got := open.On(tt.date, loc)
+		if !got.Equal(tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.desc, tt.want, got.UTC())
+		}
+		if got.Location() != loc {
+			t.Errorf("%s: expected location %v, got %v", tt.desc, loc, got.Location())
+		}
+	}
+}
+
+func TestTimeOfDayOf(t *testing.T) {
+	hk, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	instant := time.Date(2026, 1, 19, 1, 30, 15, 999, time.UTC)
+	if got, want := TimeOfDayOf(instant.In(hk)), (TimeOfDay{Hour: 9, Minute: 30, Second: 15}); got != want {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestTimeOfDay_TextAndJSON(t *testing.T) {
+	data, err := json.Marshal(TimeRange{Start: TimeOfDay{Hour: 20}, End: TimeOfDay{Hour: 4, Second: 30}})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if want := `{"Start":"20:00","End":"04:00:30"}`; string(data) != want {
+		t.Errorf("Expected %s, got %s", want, data)
+	}
+
+	var decoded TimeRange
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if decoded.Start != (TimeOfDay{Hour: 20}) || decoded.End != (TimeOfDay{Hour: 4, Second: 30}) {
+		t.Errorf("Round trip mismatch: %+v", decoded)
+	}
+
+	if err := json.Unmarshal([]byte(`{"Start":"25:00"}`), &decoded); err == nil {
+		t.Error("Expected error for an invalid time of day")
+	}
+	if _, err := (TimeOfDay{Hour: 24}).MarshalText(); err == nil {
+		t.Error("Expected error when marshaling an invalid time of day")
+	}
+}
@@ -0,0 +1,92 @@
+package marketchecker
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	// Embed the IANA timezone database so market zones, including their DST
+	// rules, load correctly in environments without system tzdata
+	_ "time/tzdata"
+)
+
+// degradedZones records the timezones that had to fall back to a fixed offset
+var degradedZones = &timezoneHealth{}
+
+// timezoneHealth collects timezone load failures
+type timezoneHealth struct {
+	mu     sync.Mutex
+	errors []error
+	names  map[string]bool
+}
+
+// record adds a timezone load failure, once per timezone
+func (h *timezoneHealth) record(err *TimezoneError) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.names == nil {
+		h.names = make(map[string]bool)
+	}
+	if h.names[err.Name] {
+		return
+	}
+	h.names[err.Name] = true
+	h.errors = append(h.errors, err)
+}
+
+// err returns the recorded failures joined into one error, or nil if there are none
+func (h *timezoneHealth) err() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return errors.Join(h.errors...)
+}
+
+// TimezoneHealth reports whether all market timezones loaded with their full rules
+// It returns nil when every zone loaded, and otherwise an error matching ErrTimezoneUnavailable
+// that lists each zone replaced by a fixed UTC offset. Markets in a degraded zone ignore
+// daylight saving time, so services should treat a non-nil result as unhealthy.
+// Because the timezone database is embedded in the package, this only happens if the
+// embedded copy cannot be read either.
+func TimezoneHealth() error {
+	return degradedZones.err()
+}
+
+// loadLocation loads the named timezone, reporting failures as a TimezoneError
+func loadLocation(name string) (*time.Location, error) {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, &TimezoneError{Name: name, Err: err}
+	}
+	return loc, nil
+}
+
+// mustLoadLocation loads a market timezone, falling back to its standard UTC offset if it cannot be loaded
+// Fallbacks are recorded and reported by TimezoneHealth. Unknown zones without a fallback panic.
+func mustLoadLocation(name string) *time.Location {
+	loc, err := loadLocation(name)
+	if err == nil {
+		return loc
+	}
+	return fallbackLocation(degradedZones, name, err.(*TimezoneError))
+}
+
+// fallbackLocation records the load failure and returns the fixed standard offset of a market timezone
+func fallbackLocation(health *timezoneHealth, name string, err *TimezoneError) *time.Location {
+	var loc *time.Location
+	switch name {
+	case "America/New_York", "America/Toronto":
+		loc = time.FixedZone("EST", -5*3600) // Wrong by an hour during daylight saving time
+	case "Asia/Hong_Kong":
+		loc = time.FixedZone("HKT", 8*3600)
+	case "Asia/Shanghai":
+		loc = time.FixedZone("CST", 8*3600)
+	case "America/Sao_Paulo":
+		loc = time.FixedZone("BRT", -3*3600)
+	case "America/Mexico_City":
+		loc = time.FixedZone("CST", -6*3600)
+	default:
+		panic(err)
+	}
+	health.record(err)
+	return loc
+}
@@ -0,0 +1,70 @@
+package marketchecker
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestTimezoneHealth(t *testing.T) {
+	if err := TimezoneHealth(); err != nil {
+		t.Errorf("Expected all market timezones to load, got %v", err)
+	}
+
+	// Market zones carry their DST rules
+	tests := []struct {
+		loc    *time.Location
+		winter int
+		summer int
+		desc   string
+	}{
+		{nasdaqLocation, -5 * 3600, -4 * 3600, "New York"},
+		{tsxLocation, -5 * 3600, -4 * 3600, "Toronto"},
+		{hkexLocation, 8 * 3600, 8 * 3600, "Hong Kong"},
+		{chinaLocation, 8 * 3600, 8 * 3600, "Shanghai"},
+	}
+	for _, tt := range tests {
+		_, winter := time.Date(2026, 1, 15, 12, 0, 0, 0, tt.loc).Zone()
+		_, summer := time.Date(2026, 7, 15, 12, 0, 0, 0, tt.loc).Zone()
+		if winter != tt.winter || summer != tt.summer {
+			t.Errorf("%s: expected offsets %d/%d, got %d/%d", tt.desc, tt.winter, tt.summer, winter, summer)
+		}
+	}
+}
+
+func TestFallbackLocation(t *testing.T) {
+	health := &timezoneHealth{}
+	if err := health.err(); err != nil {
+		t.Fatalf("Expected no degradation, got %v", err)
+	}
+
+	loadErr := &TimezoneError{Name: "America/New_York", Err: errors.New("missing tzdata")}
+	loc := fallbackLocation(health, "America/New_York", loadErr)
+	if _, offset := time.Date(2026, 1, 15, 12, 0, 0, 0, loc).Zone(); offset != -5*3600 {
+		t.Errorf("Expected fixed UTC-5 fallback, got offset %d", offset)
+	}
+	// Each zone is reported once
+	fallbackLocation(health, "America/New_York", loadErr)
+	fallbackLocation(health, "Asia/Hong_Kong", &TimezoneError{Name: "Asia/Hong_Kong", Err: errors.New("missing tzdata")})
+
+	err := health.err()
+	if !errors.Is(err, ErrTimezoneUnavailable) {
+		t.Errorf("Expected ErrTimezoneUnavailable, got %v", err)
+	}
+	var tzErr *TimezoneError
+	if !errors.As(err, &tzErr) || tzErr.Name != "America/New_York" {
+		t.Errorf("Expected TimezoneError for America/New_York, got %v", err)
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); !ok || len(joined.Unwrap()) != 2 {
+		t.Errorf("Expected two degraded zones, got %v", err)
+	}
+}
+
+func TestFallbackLocation_UnknownZonePanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic for a zone without fallback")
+		}
+	}()
+	fallbackLocation(&timezoneHealth{}, "Not/A_Zone", &TimezoneError{Name: "Not/A_Zone", Err: errors.New("unknown zone")})
+}
@@ -0,0 +1,83 @@
+package marketchecker
+
+import (
+	"time"
+)
+
+// TSX represents the Toronto Stock Exchange
+type TSX struct {
+	holidayProvider HolidayProvider
+}
+
+var (
+	// TSX timezone (Eastern Time)
+	tsxLocation *time.Location
+)
+
+func init() {
+	tsxLocation = mustLoadLocation("America/Toronto")
+}
+
+// NewTSX creates a new TSX market instance
+func NewTSX() *TSX {
+	return &TSX{
+		holidayProvider: NewTSXHolidayProvider(tsxLocation),
+	}
+}
+
+// Name returns the market name
+func (x *TSX) Name() string {
+	return "TSX"
+}
+
+// Location returns the market timezone
+func (x *TSX) Location() *time.Location {
+	return tsxLocation
+}
+
+// IsOpen checks if TSX is open for regular trading at the given time
+func (x *TSX) IsOpen(t time.Time) bool {
+	status := x.GetStatus(t)
+	return status == StatusOpen
+}
+
+// GetStatus returns the current market status at the given time
+func (x *TSX) GetStatus(t time.Time) MarketStatus {
+	return statusAt(x, t)
+}
+
+// SessionsOn returns the TSX trading sessions belonging to the given trading date
+func (x *TSX) SessionsOn(date time.Time) []Session {
+	day := startOfDay(date, tsxLocation)
+	if !x.IsTradingDay(day) {
+		return nil
+	}
+
+	// TSX trading hours (Eastern Time):
+	// Regular session: 9:30 AM - 4:00 PM
+	// Post-market crossing session: 4:15 PM - 5:00 PM
+
+	regular := TimeRange{
+		Start: TimeOfDay{Hour: 9, Minute: 30},
+		End:   TimeOfDay{Hour: 16},
+	}
+
+	postmarket := TimeRange{
+		Start: TimeOfDay{Hour: 16, Minute: 15},
+		End:   TimeOfDay{Hour: 17},
+	}
+
+	return []Session{
+		newSession(x.Name(), "regular", StatusOpen, day, regular, day),
+		newSession(x.Name(), "postmarket", StatusPostmarket, day, postmarket, day),
+	}
+}
+
+// IsTradingDay checks if the given date is a TSX trading day
+func (x *TSX) IsTradingDay(t time.Time) bool {
+	localTime := t.In(tsxLocation)
+	if x.holidayProvider != nil && x.holidayProvider.IsHoliday(localTime) {
+		return false
+	}
+	return !IsWeekend(localTime)
+}
@@ -0,0 +1,103 @@
+package marketchecker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTSX_RegularHours(t *testing.T) {
+	tsx := NewTSX()
+
+	// Test regular trading hours - Tuesday 10:00 AM ET
+	loc, err := time.LoadLocation("America/Toronto")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+	regularTime := time.Date(2026, 1, 20, 10, 0, 0, 0, loc) // Tuesday
+
+	if !tsx.IsOpen(regularTime) {
+		t.Errorf("TSX should be open at %v", regularTime)
+	}
+
+	status := tsx.GetStatus(regularTime)
+	if status != StatusOpen {
+		t.Errorf("Expected status %s, got %s", StatusOpen, status)
+	}
+}
+
+func TestTSX_Postmarket(t *testing.T) {
+	tsx := NewTSX()
+
+	loc, err := time.LoadLocation("America/Toronto")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	// Gap between the close and the post-market crossing session
+	gap := time.Date(2026, 1, 20, 16, 5, 0, 0, loc)
+	if status := tsx.GetStatus(gap); status != StatusClosed {
+		t.Errorf("Expected status %s at 4:05 PM, got %s", StatusClosed, status)
+	}
+
+	postmarketTime := time.Date(2026, 1, 20, 16, 30, 0, 0, loc)
+	if status := tsx.GetStatus(postmarketTime); status != StatusPostmarket {
+		t.Errorf("Expected status %s at 4:30 PM, got %s", StatusPostmarket, status)
+	}
+}
+
+func TestTSX_WeekendClosed(t *testing.T) {
+	tsx := NewTSX()
+
+	loc, err := time.LoadLocation("America/Toronto")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+	weekendTime := time.Date(2026, 1, 17, 10, 0, 0, 0, loc) // Saturday
+
+	if tsx.IsOpen(weekendTime) {
+		t.Errorf("TSX should be closed on weekend at %v", weekendTime)
+	}
+}
+
+func TestTSX_Name(t *testing.T) {
+	tsx := NewTSX()
+	if tsx.Name() != "TSX" {
+		t.Errorf("Expected name 'TSX', got '%s'", tsx.Name())
+	}
+}
+
+func TestTSX_HolidayClosed(t *testing.T) {
+	tsx := NewTSX()
+
+	loc, err := time.LoadLocation("America/Toronto")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	holidays := []struct {
+		name string
+		date time.Time
+	}{
+		{"Family Day", time.Date(2026, 2, 16, 10, 0, 0, 0, loc)},
+		{"Good Friday", time.Date(2026, 4, 3, 10, 0, 0, 0, loc)},
+		{"Victoria Day", time.Date(2026, 5, 18, 10, 0, 0, 0, loc)},
+		{"Canada Day", time.Date(2026, 7, 1, 10, 0, 0, 0, loc)},
+		{"Civic Holiday", time.Date(2026, 8, 3, 10, 0, 0, 0, loc)},
+		{"Thanksgiving", time.Date(2026, 10, 12, 10, 0, 0, 0, loc)},
+		{"Christmas", time.Date(2026, 12, 25, 10, 0, 0, 0, loc)},
+		{"Boxing Day (observed)", time.Date(2026, 12, 28, 10, 0, 0, 0, loc)},
+		{"Canada Day (observed)", time.Date(2029, 7, 2, 10, 0, 0, 0, loc)},
+	}
+
+	for _, h := range holidays {
+		if tsx.IsOpen(h.date) {
+			t.Errorf("TSX should be closed on %s at %v", h.name, h.date)
+		}
+	}
+
+	// US-only holidays are regular trading days in Toronto
+	mlkDay := time.Date(2026, 1, 19, 10, 0, 0, 0, loc)
+	if !tsx.IsOpen(mlkDay) {
+		t.Errorf("TSX should be open on MLK Day at %v", mlkDay)
+	}
+}
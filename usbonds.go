@@ -0,0 +1,72 @@
+package marketchecker
+
+import (
+	"time"
+)
+
+// USBonds represents the US bond market following the SIFMA recommended calendar
+type USBonds struct {
+	holidayProvider HolidayProvider
+}
+
+// NewUSBonds creates a new US bond market instance
+func NewUSBonds() *USBonds {
+	return &USBonds{
+		holidayProvider: NewSIFMAHolidayProvider(nasdaqLocation),
+	}
+}
+
+// Name returns the market name
+func (b *USBonds) Name() string {
+	return "US Bonds"
+}
+
+// Location returns the market timezone
+func (b *USBonds) Location() *time.Location {
+	return nasdaqLocation
+}
+
+// IsOpen checks if the US bond market is open at the given time
+func (b *USBonds) IsOpen(t time.Time) bool {
+	status := b.GetStatus(t)
+	return status == StatusOpen
+}
+
+// GetStatus returns the current market status at the given time
+func (b *USBonds) GetStatus(t time.Time) MarketStatus {
+	return statusAt(b, t)
+}
+
+// SessionsOn returns the US bond market sessions belonging to the given trading date
+func (b *USBonds) SessionsOn(date time.Time) []Session {
+	day := startOfDay(date, nasdaqLocation)
+	if !b.IsTradingDay(day) {
+		return nil
+	}
+
+	// SIFMA recommended trading hours (Eastern Time):
+	// Regular session: 8:00 AM - 5:00 PM
+	// Early close days: 8:00 AM - 2:00 PM
+
+	regular := TimeRange{
+		Start: TimeOfDay{Hour: 8},
+		End:   TimeOfDay{Hour: 17},
+	}
+
+	if earlyClose, ok := b.holidayProvider.(EarlyCloseProvider); ok && earlyClose.IsEarlyClose(day) {
+		regular.End = TimeOfDay{Hour: 14}
+	}
+
+	return []Session{
+		newSession(b.Name(), "regular", StatusOpen, day, regular, day),
+	}
+}
+
+// IsTradingDay checks if the given date is a US bond market trading day
+func (b *USBonds) IsTradingDay(t time.Time) bool {
+	localTime := t.In(nasdaqLocation)
+	if b.holidayProvider != nil && b.holidayProvider.IsHoliday(localTime) {
+		return false
+	}
+	return !IsWeekend(localTime)
+}
@@ -0,0 +1,108 @@
+package marketchecker
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUSBonds_RegularHours(t *testing.T) {
+	bonds := NewUSBonds()
+
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	if !bonds.IsOpen(time.Date(2026, 1, 20, 8, 30, 0, 0, loc)) {
+		t.Error("US bond market should be open at 8:30 AM ET")
+	}
+	if !bonds.IsOpen(time.Date(2026, 1, 20, 16, 30, 0, 0, loc)) {
+		t.Error("US bond market should be open at 4:30 PM ET")
+	}
+	if bonds.IsOpen(time.Date(2026, 1, 20, 17, 30, 0, 0, loc)) {
+		t.Error("US bond market should be closed at 5:30 PM ET")
+	}
+	if bonds.IsOpen(time.Date(2026, 1, 17, 10, 0, 0, 0, loc)) {
+		t.Error("US bond market should be closed on Saturday")
+	}
+}
+
+func TestUSBonds_HolidayClosed(t *testing.T) {
+	bonds := NewUSBonds()
+	nasdaq := NewNASDAQ()
+
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	// Bond-market-only holidays: the stock market is open
+	bondOnly := []struct {
+		name string
+		date time.Time
+	}{
+		{"Columbus Day", time.Date(2026, 10, 12, 10, 0, 0, 0, loc)},
+		{"Veterans Day", time.Date(2026, 11, 11, 10, 0, 0, 0, loc)},
+	}
+	for _, h := range bondOnly {
+		if bonds.IsOpen(h.date) {
+			t.Errorf("US bond market should be closed on %s at %v", h.name, h.date)
+		}
+		if !nasdaq.IsOpen(h.date) {
+			t.Errorf("NASDAQ should be open on %s at %v", h.name, h.date)
+		}
+	}
+
+	thanksgiving := time.Date(2026, 11, 26, 10, 0, 0, 0, loc)
+	if bonds.IsOpen(thanksgiving) {
+		t.Errorf("US bond market should be closed on Thanksgiving at %v", thanksgiving)
+	}
+
+	// New Year's Day on a Saturday does not close the market on Friday
+	newYearsEve := time.Date(2021, 12, 31, 10, 0, 0, 0, loc)
+	if !bonds.IsOpen(newYearsEve) {
+		t.Errorf("US bond market should be open on New Year's Eve at %v", newYearsEve)
+	}
+}
+
+func TestUSBonds_EarlyClose(t *testing.T) {
+	bonds := NewUSBonds()
+
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	earlyCloses := []struct {
+		name string
+		date time.Time
+	}{
+		{"Friday before MLK Day", time.Date(2026, 1, 16, 0, 0, 0, 0, loc)},
+		{"Thursday before Good Friday", time.Date(2026, 4, 2, 0, 0, 0, 0, loc)},
+		{"Day before Independence Day (observed)", time.Date(2026, 7, 2, 0, 0, 0, 0, loc)},
+		{"Day after Thanksgiving", time.Date(2026, 11, 27, 0, 0, 0, 0, loc)},
+		{"Christmas Eve", time.Date(2026, 12, 24, 0, 0, 0, 0, loc)},
+		{"New Year's Eve", time.Date(2026, 12, 31, 0, 0, 0, 0, loc)},
+	}
+	for _, ec := range earlyCloses {
+		if !bonds.IsOpen(ec.date.Add(13 * time.Hour)) {
+			t.Errorf("US bond market should be open at 1:00 PM on %s", ec.name)
+		}
+		if bonds.IsOpen(ec.date.Add(15 * time.Hour)) {
+			t.Errorf("US bond market should be closed at 3:00 PM on %s", ec.name)
+		}
+	}
+
+	// No early close before Columbus Day
+	fridayBeforeColumbus := time.Date(2026, 10, 9, 15, 0, 0, 0, loc)
+	if !bonds.IsOpen(fridayBeforeColumbus) {
+		t.Errorf("US bond market should be open at %v", fridayBeforeColumbus)
+	}
+}
+
+func TestUSBonds_Name(t *testing.T) {
+	bonds := NewUSBonds()
+	if bonds.Name() != "US Bonds" {
+		t.Errorf("Expected name 'US Bonds', got '%s'", bonds.Name())
+	}
+}
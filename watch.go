@@ -0,0 +1,163 @@
+package marketchecker
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// transitionSearchWindow is the span of sessions inspected at a time when looking for the next transition
+const transitionSearchWindow = 7 * 24 * time.Hour
+
+// StatusChange is emitted by Watch when a market changes status
+// A change with a non-nil Err is the last one emitted for its market: the
+// market's next transition could not be determined, e.g. because it lies
+// beyond the market's holiday data (ErrCalendarNotCovered). From then holds the
+// last known status and To and At are empty.
+type StatusChange struct {
+	Market MarketType
+	From   MarketStatus
+	To     MarketStatus
+	At     time.Time // Exact session boundary at which the change happens
+	Err    error
+}
+
+// Watch emits the status changes of the specified markets on the returned channel as they happen
+// Changes are computed from the session schedules and delivered at the session boundaries
+// according to the checker's clock, without polling. A market stops being watched once its
+// holiday data runs out, after a final change carrying the error. The channel is closed when ctx is done.
+func (c *Checker) Watch(ctx context.Context, markets ...MarketType) (<-chan StatusChange, error) {
+	if len(markets) == 0 {
+		return nil, fmt.Errorf("no markets to watch")
+	}
+
+	watched := make([]*watchedMarket, 0, len(markets))
+	now := c.clock.Now()
+	for _, marketType := range markets {
+		schedule, err := c.sessionSchedule(marketType, now, now)
+		if err != nil {
+			return nil, err
+		}
+		w := &watchedMarket{marketType: marketType, schedule: schedule, status: statusAt(schedule, now)}
+		w.advance(now)
+		watched = append(watched, w)
+	}
+
+	changes := make(chan StatusChange)
+	go c.watch(ctx, watched, changes)
+	return changes, nil
+}
+
+// watch delivers the status changes of the watched markets until ctx is done
+func (c *Checker) watch(ctx context.Context, watched []*watchedMarket, changes chan<- StatusChange) {
+	defer close(changes)
+
+	for {
+		// Report markets whose next transition is unknown and stop watching them
+		for _, w := range watched {
+			if w.err == nil {
+				continue
+			}
+			select {
+			case changes <- StatusChange{Market: w.marketType, From: w.status, Err: w.err}:
+			case <-ctx.Done():
+				return
+			}
+			w.err = nil
+		}
+
+		// Wait for the earliest upcoming transition of any market
+		var next time.Time
+		for _, w := range watched {
+			if w.hasNext && (next.IsZero() || w.next.Before(next)) {
+				next = w.next
+			}
+		}
+
+		var timer Timer
+		var fired <-chan time.Time
+		if !next.IsZero() {
+			timer = c.clock.NewTimer(next.Sub(c.clock.Now()))
+			fired = timer.C()
+		}
+		select {
+		case <-ctx.Done():
+			if timer != nil {
+				timer.Stop()
+			}
+			return
+		case <-fired:
+		}
+
+		for _, w := range watched {
+			if !w.hasNext || w.next.After(next) {
+				continue
+			}
+			at := w.next
+			status := statusAt(w.schedule, at)
+			from := w.status
+			w.status = status
+			w.advance(at)
+
+			// Back-to-back sessions may share a status
+			if status == from {
+				continue
+			}
+			select {
+			case changes <- StatusChange{Market: w.marketType, From: from, To: status, At: at}:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}
+}
+
+// watchedMarket tracks the last known status and the next transition of a watched market
+type watchedMarket struct {
+	marketType MarketType
+	schedule   SessionSchedule
+	status     MarketStatus
+	next       time.Time
+	hasNext    bool
+	err        error // Set when the next transition cannot be determined; the market is then no longer watched
+}
+
+// advance computes the next transition of the market after t
+func (w *watchedMarket) advance(t time.Time) {
+	w.next, w.hasNext, w.err = nextTransition(w.schedule, t)
+}
+
+// nextTransition returns the first session boundary of the schedule strictly after t
+// Returns a CalendarNotCoveredError if the boundary or the trading date of its
+// session lies outside the holiday data of the schedule.
+func nextTransition(schedule SessionSchedule, t time.Time) (time.Time, bool, error) {
+	from := t
+	for i := 0; i*int(transitionSearchWindow/(24*time.Hour)) < maxTradingDaySearch; i++ {
+		to := from.Add(transitionSearchWindow)
+
+		var next time.Time
+		var tradingDate time.Time
+		for _, session := range sessionsBetween(schedule, from, to) {
+			for _, boundary := range []time.Time{session.Start, session.End} {
+				if boundary.After(t) && (next.IsZero() || boundary.Before(next)) {
+					next = boundary
+					tradingDate = session.TradingDate
+				}
+			}
+		}
+		if !next.IsZero() {
+			if err := checkCoverage(schedule, tradingDate); err != nil {
+				return time.Time{}, false, err
+			}
+			if err := checkCoverage(schedule, next); err != nil {
+				return time.Time{}, false, err
+			}
+			return next, true, nil
+		}
+		if err := checkCoverage(schedule, to); err != nil {
+			return time.Time{}, false, err
+		}
+		from = to
+	}
+	return time.Time{}, false, nil
+}
@@ -0,0 +1,165 @@
+package marketchecker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestChecker_Watch(t *testing.T) {
+	hk, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	clock := NewFakeClock(time.Date(2026, 1, 23, 15, 0, 0, 0, hk))
+	checker := NewChecker(WithClock(clock))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	changes, err := checker.Watch(ctx, MarketHKEX)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Nothing happens until the clock reaches the close
+	clock.BlockUntil(1)
+	clock.Advance(59 * time.Minute)
+	select {
+	case change := <-changes:
+		t.Fatalf("Unexpected change before the close: %+v", change)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	// Simulate the rest of the trading week
+	clock.Advance(4 * 24 * time.Hour)
+
+	want := []StatusChange{
+		{MarketHKEX, StatusOpen, StatusClosed, time.Date(2026, 1, 23, 16, 0, 0, 0, hk), nil},
+		{MarketHKEX, StatusClosed, StatusOpen, time.Date(2026, 1, 26, 9, 30, 0, 0, hk), nil},
+		{MarketHKEX, StatusOpen, StatusClosed, time.Date(2026, 1, 26, 12, 0, 0, 0, hk), nil},
+		{MarketHKEX, StatusClosed, StatusOpen, time.Date(2026, 1, 26, 13, 0, 0, 0, hk), nil},
+	}
+	for i, w := range want {
+		got := <-changes
+		if got.Market != w.Market || got.From != w.From || got.To != w.To || !got.At.Equal(w.At) {
+			t.Errorf("Change %d: expected %+v, got %+v", i, w, got)
+		}
+	}
+
+	cancel()
+	for range changes {
+	}
+}
+
+func TestChecker_WatchMultipleMarkets(t *testing.T) {
+	ny, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	clock := NewFakeClock(time.Date(2026, 1, 27, 9, 0, 0, 0, ny))
+	checker := NewChecker(WithClock(clock))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	changes, err := checker.Watch(ctx, MarketNASDAQ, MarketTSX)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	clock.BlockUntil(1)
+	clock.Advance(24 * time.Hour)
+
+	// Both markets open at 9:30 and are reported in chronological order
+	want := []StatusChange{
+		{MarketNASDAQ, StatusPremarket, StatusOpen, time.Date(2026, 1, 27, 9, 30, 0, 0, ny), nil},
+		{MarketTSX, StatusClosed, StatusOpen, time.Date(2026, 1, 27, 9, 30, 0, 0, ny), nil},
+		{MarketNASDAQ, StatusOpen, StatusPostmarket, time.Date(2026, 1, 27, 16, 0, 0, 0, ny), nil},
+	}
+	for i, w := range want {
+		got := <-changes
+		if got.Market != w.Market || got.From != w.From || got.To != w.To || !got.At.Equal(w.At) {
+			t.Errorf("Change %d: expected %+v, got %+v", i, w, got)
+		}
+	}
+}
+
+func TestChecker_WatchCancel(t *testing.T) {
+	checker := NewChecker()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	changes, err := checker.Watch(ctx, MarketNASDAQ)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	cancel()
+
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case _, ok := <-changes:
+			if !ok {
+				return
+			}
+		case <-timeout:
+			t.Fatal("Expected channel to be closed after cancellation")
+		}
+	}
+}
+
+func TestChecker_WatchErrors(t *testing.T) {
+	checker := NewChecker()
+
+	if _, err := checker.Watch(context.Background()); err == nil {
+		t.Error("Expected error when watching no markets")
+	}
+	if _, err := checker.Watch(context.Background(), "UNKNOWN"); err == nil {
+		t.Error("Expected error for unknown market type")
+	}
+}
+
+func TestChecker_WatchEndOfCalendarCoverage(t *testing.T) {
+	hk, err := time.LoadLocation("Asia/Hong_Kong")
+	if err != nil {
+		t.Fatalf("Failed to load timezone: %v", err)
+	}
+
+	// New Year's Eve is a half day and the last trading date of the HKEX holiday data
+	clock := NewFakeClock(time.Date(2026, 12, 31, 11, 0, 0, 0, hk))
+	checker := NewChecker(WithClock(clock))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	changes, err := checker.Watch(ctx, MarketHKEX)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	clock.BlockUntil(1)
+	clock.Advance(2 * time.Hour)
+
+	got := <-changes
+	if got.Err != nil || got.To != StatusClosed || !got.At.Equal(time.Date(2026, 12, 31, 12, 0, 0, 0, hk)) {
+		t.Errorf("Expected the half-day close, got %+v", got)
+	}
+
+	// The next open lies beyond the holiday data, so watching stops with an error
+	got = <-changes
+	if !errors.Is(got.Err, ErrCalendarNotCovered) || got.Market != MarketHKEX || got.From != StatusClosed {
+		t.Errorf("Expected a final change with ErrCalendarNotCovered, got %+v", got)
+	}
+
+	clock.Advance(7 * 24 * time.Hour)
+	select {
+	case change := <-changes:
+		t.Errorf("Unexpected change after the holiday data ran out: %+v", change)
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	cancel()
+	for range changes {
+	}
+}